@@ -4,6 +4,7 @@ import (
 	"flag"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"k8s.io/client-go/util/homedir"
 )
@@ -22,6 +23,9 @@ func getMcpServerCliFlags() McpServerUserConfig {
 		logLevel        = flag.String("log-level", os.Getenv("KUBE_MCP_LOG_LEVEL"), "Application log level: debug, info, warn, error")
 		allowedTools    = flag.String("allowed-tools", os.Getenv("KUBE_MCP_ALLOWED_TOOLS"), "(optional) comma-separated list of allowed tools")
 		disallowedTools = flag.String("disallowed-tools", os.Getenv("KUBE_MCP_DISALLOWED_TOOLS"), "(optional) comma-separated list of disallowed tools")
+		confirmTools    = flag.String("confirm-tools", os.Getenv("KUBE_MCP_CONFIRM_TOOLS"), "(optional) comma-separated list of write tools requiring user confirmation, defaults to all write tools. Use 'none' to disable confirmation")
+		promptsDir      = flag.String("prompts-dir", os.Getenv("KUBE_MCP_PROMPTS_DIR"), "(optional) directory of custom prompt template files to load at startup")
+		writeMode       = flag.Bool("write-mode", strings.EqualFold(os.Getenv("KUBE_MCP_WRITE_MODE"), "true"), "(optional) enables tools that modify resources in the cluster")
 	)
 
 	// Attempt to resolve a local kubeconfig path.
@@ -47,6 +51,9 @@ func getMcpServerCliFlags() McpServerUserConfig {
 		LogLevel:        *logLevel,
 		AllowedTools:    *allowedTools,
 		DisallowedTools: *disallowedTools,
+		ConfirmTools:    *confirmTools,
+		WriteMode:       *writeMode,
+		PromptsDir:      *promptsDir,
 		SigningMethod:   *signingMethod,
 		Scopes:          *scopes,
 	}
@@ -21,6 +21,9 @@ type McpServerConfig struct {
 	Scopes          []string
 	SigningMethod   string
 	LogLevel        string
+	ConfirmTools    []string
+	WriteMode       bool
+	PromptsDir      string
 }
 
 type McpServerUserConfig struct {
@@ -37,6 +40,9 @@ type McpServerUserConfig struct {
 	Scopes          string
 	SigningMethod   string
 	LogLevel        string
+	ConfirmTools    string
+	WriteMode       bool
+	PromptsDir      string
 }
 
 func parseServerUserConfig(config McpServerUserConfig) {
@@ -77,6 +83,7 @@ func GetMcpServerConfig() McpServerConfig {
 	allowedOrigins := splitStringArg(config.AllowedOrigins)
 	allowedTools := splitStringArg(config.AllowedTools)
 	disallowedTools := splitStringArg(config.DisallowedTools)
+	confirmTools := splitStringArg(config.ConfirmTools)
 	scopes := splitStringArg(config.Scopes)
 
 	if len(scopes) == 0 {
@@ -114,6 +121,9 @@ func GetMcpServerConfig() McpServerConfig {
 		DisallowedTools: disallowedTools,
 		SigningMethod:   config.SigningMethod,
 		Scopes:          scopes,
+		ConfirmTools:    confirmTools,
+		WriteMode:       config.WriteMode,
+		PromptsDir:      config.PromptsDir,
 	}
 }
 
@@ -0,0 +1,76 @@
+package helm
+
+import (
+	"regexp"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// MaskedValue replaces masked values.
+const MaskedValue = "********"
+
+// sensitiveKey matches value keys which are likely to hold secrets.
+var sensitiveKey = regexp.MustCompile(`(?i:password|passwd|secret|token|credential|api_?key|auth|private|cert)|^key$|Key$|[_-]key$`)
+
+// MaskValues replaces the scalar values of keys which look sensitive, and
+// those nested under them, so that user-supplied values can be shown without
+// their secrets. Name and value pairs, e.g. container env entries, are masked
+// when the name looks sensitive.
+func MaskValues(value any, masked bool) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		sensitiveName := false
+		if name, ok := typed["name"].(string); ok {
+			sensitiveName = sensitiveKey.MatchString(name)
+		}
+		result := make(map[string]any, len(typed))
+		for key, nested := range typed {
+			result[key] = MaskValues(nested, masked || sensitiveKey.MatchString(key) || (sensitiveName && key == "value"))
+		}
+		return result
+	case []any:
+		result := make([]any, len(typed))
+		for i, nested := range typed {
+			result[i] = MaskValues(nested, masked)
+		}
+		return result
+	}
+	// Every scalar under a sensitive key is masked, e.g. a numeric password or pin.
+	if masked {
+		return MaskedValue
+	}
+	return value
+}
+
+// MaskManifestSecrets masks the data of any Secrets in a rendered manifest,
+// leaving the other documents, including Helm's source comments, as rendered.
+func MaskManifestSecrets(manifest string) string {
+	documents := strings.Split(manifest, "\n---")
+	for i, document := range documents {
+		object := map[string]any{}
+		if err := yaml.Unmarshal([]byte(document), &object); err != nil || object["kind"] != "Secret" {
+			continue
+		}
+		for _, field := range []string{"data", "stringData"} {
+			if data, ok := object[field].(map[string]any); ok {
+				for key := range data {
+					data[key] = MaskedValue
+				}
+			}
+		}
+		masked, err := yaml.Marshal(object)
+		if err != nil {
+			continue
+		}
+
+		// Keep the document's leading separator and comments.
+		lines := strings.Split(document, "\n")
+		header := 0
+		for header < len(lines) && (lines[header] == "" || lines[header] == "---" || strings.HasPrefix(lines[header], "#")) {
+			header++
+		}
+		documents[i] = strings.Join(append(lines[:header], string(masked)), "\n")
+	}
+	return strings.Join(documents, "\n---")
+}
@@ -0,0 +1,117 @@
+package helm
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMaskValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		values map[string]any
+		want   map[string]any
+	}{
+		{
+			name:   "sensitive string",
+			values: map[string]any{"password": "hunter2", "replicas": float64(2)},
+			want:   map[string]any{"password": MaskedValue, "replicas": float64(2)},
+		},
+		{
+			name:   "sensitive scalars of other types",
+			values: map[string]any{"password": float64(123456), "authEnabled": true, "apiKey": nil},
+			want:   map[string]any{"password": MaskedValue, "authEnabled": MaskedValue, "apiKey": MaskedValue},
+		},
+		{
+			name:   "nested under a sensitive key",
+			values: map[string]any{"auth": map[string]any{"username": "admin", "hosts": []any{"a", "b"}}},
+			want:   map[string]any{"auth": map[string]any{"username": MaskedValue, "hosts": []any{MaskedValue, MaskedValue}}},
+		},
+		{
+			name: "env list with a sensitive name",
+			values: map[string]any{"env": []any{
+				map[string]any{"name": "DB_PASSWORD", "value": "hunter2"},
+				map[string]any{"name": "LOG_LEVEL", "value": "debug"},
+			}},
+			want: map[string]any{"env": []any{
+				map[string]any{"name": "DB_PASSWORD", "value": MaskedValue},
+				map[string]any{"name": "LOG_LEVEL", "value": "debug"},
+			}},
+		},
+		{
+			name:   "keys which only contain key",
+			values: map[string]any{"monkey": "george", "keyboard": "qwerty", "key": "abc", "tls-key": "def", "accessKey": "ghi"},
+			want:   map[string]any{"monkey": "george", "keyboard": "qwerty", "key": MaskedValue, "tls-key": MaskedValue, "accessKey": MaskedValue},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := MaskValues(test.values, false); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("MaskValues() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMaskManifestSecrets(t *testing.T) {
+	tests := []struct {
+		name        string
+		manifest    string
+		contains    []string
+		notContains []string
+	}{
+		{
+			name: "secret with separator and source comment",
+			manifest: `---
+# Source: app/templates/secret.yaml
+apiVersion: v1
+kind: Secret
+metadata:
+  name: app
+data:
+  password: aHVudGVyMg==
+stringData:
+  token: abc123
+`,
+			contains:    []string{"---\n# Source: app/templates/secret.yaml\n", "password: '" + MaskedValue + "'", "token: '" + MaskedValue + "'"},
+			notContains: []string{"aHVudGVyMg==", "abc123"},
+		},
+		{
+			name: "other documents are unchanged",
+			manifest: `---
+# Source: app/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: app
+data:
+  level: debug
+---
+# Source: app/templates/secret.yaml
+apiVersion: v1
+kind: Secret
+metadata:
+  name: app
+data:
+  password: aHVudGVyMg==
+`,
+			contains:    []string{"---\n# Source: app/templates/configmap.yaml\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: app\ndata:\n  level: debug\n", "# Source: app/templates/secret.yaml\n"},
+			notContains: []string{"aHVudGVyMg=="},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := MaskManifestSecrets(test.manifest)
+			for _, want := range test.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("MaskManifestSecrets() = %q, want it to contain %q", got, want)
+				}
+			}
+			for _, unwanted := range test.notContains {
+				if strings.Contains(got, unwanted) {
+					t.Errorf("MaskManifestSecrets() = %q, want it not to contain %q", got, unwanted)
+				}
+			}
+		})
+	}
+}
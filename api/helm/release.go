@@ -0,0 +1,66 @@
+package helm
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ReleaseSecretType is the type of the secrets Helm stores releases in.
+const ReleaseSecretType = corev1.SecretType("helm.sh/release.v1")
+
+var gzipMagic = []byte{0x1f, 0x8b, 0x08}
+
+// Release is the release record Helm stores in its release secrets,
+// limited to the fields returned by the helm tools.
+type Release struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Version   int    `json:"version"`
+	Info      struct {
+		FirstDeployed string `json:"first_deployed"`
+		LastDeployed  string `json:"last_deployed"`
+		Description   string `json:"description"`
+		Status        string `json:"status"`
+		Notes         string `json:"notes"`
+	} `json:"info"`
+	Chart struct {
+		Metadata struct {
+			Name       string `json:"name"`
+			Version    string `json:"version"`
+			AppVersion string `json:"appVersion"`
+		} `json:"metadata"`
+	} `json:"chart"`
+	Config   map[string]any `json:"config"`
+	Manifest string         `json:"manifest"`
+}
+
+// DecodeRelease decodes the release payload of a Helm release secret, which
+// is gzipped JSON, base64 encoded on top of the secret's own encoding.
+func DecodeRelease(secret *corev1.Secret) (*Release, error) {
+	payload, err := base64.StdEncoding.DecodeString(string(secret.Data["release"]))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode release secret %s: %w", secret.Name, err)
+	}
+	if bytes.HasPrefix(payload, gzipMagic) {
+		reader, err := gzip.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress release secret %s: %w", secret.Name, err)
+		}
+		defer reader.Close()
+		if payload, err = io.ReadAll(reader); err != nil {
+			return nil, fmt.Errorf("failed to decompress release secret %s: %w", secret.Name, err)
+		}
+	}
+
+	release := &Release{}
+	if err := json.Unmarshal(payload, release); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal release secret %s: %w", secret.Name, err)
+	}
+	return release, nil
+}
@@ -4,9 +4,11 @@ import (
 	"log/slog"
 	"os"
 
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	metrics "k8s.io/metrics/pkg/client/clientset/versioned"
 )
 
 func resolveClusterConfig(outOfCluster bool, kubeconfig *string) *rest.Config {
@@ -38,3 +40,23 @@ func CreateKubernetesApiClient(outOfCluster bool, kubeconfig string) *kubernetes
 	}
 	return client
 }
+
+func CreateKubernetesDynamicClient(outOfCluster bool, kubeconfig string) *dynamic.DynamicClient {
+	config := resolveClusterConfig(outOfCluster, &kubeconfig)
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		slog.Error("Failed to create Kubernetes dynamic client", "error", err)
+		os.Exit(1)
+	}
+	return client
+}
+
+func CreateKubernetesMetricsClient(outOfCluster bool, kubeconfig string) *metrics.Clientset {
+	config := resolveClusterConfig(outOfCluster, &kubeconfig)
+	client, err := metrics.NewForConfig(config)
+	if err != nil {
+		slog.Error("Failed to create Kubernetes metrics client", "error", err)
+		os.Exit(1)
+	}
+	return client
+}
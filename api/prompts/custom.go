@@ -0,0 +1,140 @@
+package prompts
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"sigs.k8s.io/yaml"
+)
+
+// CustomPromptFile is the format of a custom prompt template file. Message
+// content is a Go text/template, rendered with the prompt arguments, e.g.
+// {{ .namespace }}.
+type CustomPromptFile struct {
+	Name        string                    `json:"name"`
+	Title       string                    `json:"title,omitempty"`
+	Description string                    `json:"description,omitempty"`
+	Arguments   []*mcp.PromptArgument     `json:"arguments,omitempty"`
+	NameKind    string                    `json:"nameKind,omitempty"`
+	Messages    []CustomPromptFileMessage `json:"messages"`
+}
+
+type CustomPromptFileMessage struct {
+	Role    mcp.Role `json:"role"`
+	Content string   `json:"content"`
+}
+
+type customPromptMessage struct {
+	role     mcp.Role
+	template *template.Template
+}
+
+// CustomPrompt is a prompt loaded from a template file.
+type CustomPrompt struct {
+	Prompt *mcp.Prompt
+	// NameKind is the kind of the name argument, used to complete names from the cluster.
+	NameKind string
+	messages []customPromptMessage
+}
+
+func parseCustomPrompt(path string) (*CustomPrompt, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file CustomPromptFile
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, err
+	}
+	if file.Name == "" {
+		return nil, fmt.Errorf("prompt name is required")
+	}
+	if len(file.Messages) == 0 {
+		return nil, fmt.Errorf("prompt %s has no messages", file.Name)
+	}
+
+	prompt := &CustomPrompt{
+		Prompt: &mcp.Prompt{
+			Name:        file.Name,
+			Title:       file.Title,
+			Description: file.Description,
+			Arguments:   file.Arguments,
+		},
+		NameKind: file.NameKind,
+	}
+	for i, message := range file.Messages {
+		if message.Role == "" {
+			message.Role = "user"
+		}
+		if message.Role != "user" && message.Role != "assistant" {
+			return nil, fmt.Errorf("prompt %s message %d has invalid role %q, must be user or assistant", file.Name, i, message.Role)
+		}
+		// Missing optional arguments render as empty strings rather than "<no value>".
+		messageTemplate, err := template.New(fmt.Sprintf("%s/%d", file.Name, i)).Option("missingkey=zero").Parse(message.Content)
+		if err != nil {
+			return nil, err
+		}
+		prompt.messages = append(prompt.messages, customPromptMessage{role: message.Role, template: messageTemplate})
+	}
+	return prompt, nil
+}
+
+// LoadCustomPrompts loads the custom prompt templates from the YAML or JSON files in a directory.
+func LoadCustomPrompts(dir string) ([]*CustomPrompt, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	prompts := []*CustomPrompt{}
+	for _, entry := range entries {
+		extension := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (extension != ".yaml" && extension != ".yml" && extension != ".json") {
+			continue
+		}
+		prompt, err := parseCustomPrompt(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+		prompts = append(prompts, prompt)
+	}
+	return prompts, nil
+}
+
+func (p *CustomPrompt) Handler(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	slog.Debug("Prompt requested", "prompt", req.Params.Name)
+
+	arguments := map[string]string{}
+	for _, argument := range p.Prompt.Arguments {
+		value, err := getArgument(req, argument)
+		if err != nil {
+			return nil, err
+		}
+		arguments[argument.Name] = value
+	}
+
+	result := &mcp.GetPromptResult{
+		Description: p.Prompt.Description,
+		Messages:    []*mcp.PromptMessage{},
+	}
+	for _, message := range p.messages {
+		var content strings.Builder
+		if err := message.template.Execute(&content, arguments); err != nil {
+			slog.Error("Failed to render prompt template", "prompt", req.Params.Name, "error", err)
+			return nil, err
+		}
+		result.Messages = append(result.Messages, &mcp.PromptMessage{
+			Role:    message.role,
+			Content: &mcp.TextContent{Text: content.String()},
+		})
+	}
+	return result, nil
+}
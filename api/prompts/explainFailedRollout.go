@@ -0,0 +1,51 @@
+package prompts
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var explainFailedRolloutNameArgument = &mcp.PromptArgument{
+	Name:        "name",
+	Title:       "Deployment name",
+	Description: "The name of the deployment whose rollout failed",
+	Required:    true,
+}
+
+var ExplainFailedRolloutPrompt = &mcp.Prompt{
+	Name:        "explain-failed-rollout",
+	Title:       "Explain failed rollout",
+	Description: "Explain why a deployment rollout is stuck or has failed, and how to recover",
+	Arguments:   []*mcp.PromptArgument{namespaceArgument, explainFailedRolloutNameArgument},
+}
+
+func ExplainFailedRolloutHandler(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	slog.Debug("Prompt requested", "prompt", req.Params.Name)
+
+	namespace, err := getArgument(req, namespaceArgument)
+	if err != nil {
+		return nil, err
+	}
+	name, err := getArgument(req, explainFailedRolloutNameArgument)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mcp.GetPromptResult{
+		Description: fmt.Sprintf("Explain the failed rollout of deployment %s in namespace %s", name, namespace),
+		Messages: runbookMessages(
+			fmt.Sprintf("The rollout of the deployment %s in namespace %s is stuck or has failed. Explain why.", name, namespace),
+			[]runbookStep{
+				{Tool: "describe_resource", Instruction: fmt.Sprintf("with kind Deployment, namespace %s and name %s to see its Progressing and Available conditions and events.", namespace, name)},
+				{Tool: "get_owner_tree", Instruction: fmt.Sprintf("with kind Deployment, namespace %s and name %s to see the old and new ReplicaSets and their pods.", namespace, name)},
+				{Tool: "list_replica_sets", Instruction: fmt.Sprintf("with namespace %s and summary enabled, and compare the pod template of the newest ReplicaSet with the previous revision.", namespace)},
+				{Tool: "diagnose_pod", Instruction: "for the pods of the newest ReplicaSet which are not ready."},
+				{Tool: "describe_resource", Instruction: "with kind ReplicaSet for the newest ReplicaSet if it has no pods, to find quota or admission failures in its events."},
+			},
+			"Report what changed in the failed revision, why its pods are not becoming available, and whether to fix forward or roll back to the previous revision.",
+		),
+	}, nil
+}
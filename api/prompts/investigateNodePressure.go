@@ -0,0 +1,57 @@
+package prompts
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var investigateNodePressureNameArgument = &mcp.PromptArgument{
+	Name:        "name",
+	Title:       "Node name",
+	Description: "The name of the node to investigate, leave empty to check all nodes",
+}
+
+var InvestigateNodePressurePrompt = &mcp.Prompt{
+	Name:        "investigate-node-pressure",
+	Title:       "Investigate node pressure",
+	Description: "Investigate memory, disk or PID pressure on a node and the pods contributing to it",
+	Arguments:   []*mcp.PromptArgument{investigateNodePressureNameArgument},
+}
+
+func InvestigateNodePressureHandler(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	slog.Debug("Prompt requested", "prompt", req.Params.Name)
+
+	name, err := getArgument(req, investigateNodePressureNameArgument)
+	if err != nil {
+		return nil, err
+	}
+
+	task := "Find the nodes in the cluster reporting MemoryPressure, DiskPressure or PIDPressure and explain the cause."
+	steps := []runbookStep{
+		{Tool: "list_nodes", Instruction: "and find the nodes with a pressure condition set to True, or which are NotReady or unschedulable."},
+		{Tool: "describe_resource", Instruction: "with kind Node for each affected node to see its conditions and events."},
+	}
+	if name != "" {
+		task = fmt.Sprintf("The node %s may be under MemoryPressure, DiskPressure or PIDPressure. Confirm whether it is and explain the cause.", name)
+		steps = []runbookStep{
+			{Tool: "describe_resource", Instruction: fmt.Sprintf("with kind Node and name %s to see its conditions, capacity, allocatable resources and events.", name)},
+		}
+	}
+	steps = append(steps,
+		runbookStep{Tool: "list_pods", Instruction: "for all namespaces and find the pods scheduled on the affected nodes using spec.nodeName."},
+		runbookStep{Tool: "list_events", Instruction: "with type Warning and look for Evicted, OOMKilling, FreeDiskSpaceFailed and ImageGCFailed events on the affected nodes and their pods."},
+		runbookStep{Tool: "diagnose_pod", Instruction: "for any pods on the affected nodes which have been evicted or OOM killed."},
+	)
+
+	return &mcp.GetPromptResult{
+		Description: "Investigate node pressure",
+		Messages: runbookMessages(
+			task,
+			steps,
+			"Report which nodes are under pressure and which resource, the pods most likely responsible, and recommend changes such as resource requests and limits, eviction thresholds or cordoning the node.",
+		),
+	}, nil
+}
@@ -0,0 +1,64 @@
+package prompts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	tools "github.com/cturner8/kube-mcp/tools"
+)
+
+var namespaceArgument = &mcp.PromptArgument{
+	Name:        "namespace",
+	Title:       "Namespace",
+	Description: "The namespace of the resource",
+	Required:    true,
+}
+
+// NameArgumentKinds are the kinds of the name argument of each prompt, used
+// to complete names from the cluster.
+var NameArgumentKinds = map[string]string{
+	TroubleshootPodPrompt.Name:         "pods",
+	InvestigateNodePressurePrompt.Name: "nodes",
+	ExplainFailedRolloutPrompt.Name:    "deployments.apps",
+}
+
+// runbookStep is a single step of a runbook prompt, performed with a kube-mcp tool.
+type runbookStep struct {
+	Tool        string
+	Instruction string
+}
+
+// getArgument returns the value of a prompt argument, erroring if a required
+// argument is missing.
+func getArgument(req *mcp.GetPromptRequest, argument *mcp.PromptArgument) (string, error) {
+	value := strings.TrimSpace(req.Params.Arguments[argument.Name])
+	if value == "" && argument.Required {
+		return "", fmt.Errorf("prompt %s requires the %s argument", req.Params.Name, argument.Name)
+	}
+	return value, nil
+}
+
+// runbookMessages builds the message sequence for a runbook prompt: the task,
+// the tool calls to make and how to report back. Steps using tools which are
+// not enabled on this server are left out.
+func runbookMessages(task string, steps []runbookStep, report string) []*mcp.PromptMessage {
+	var instructions strings.Builder
+	instructions.WriteString("Use the kube-mcp tools to investigate, in this order:\n")
+	number := 1
+	for _, step := range steps {
+		if !tools.IsToolAllowed(step.Tool) {
+			continue
+		}
+		fmt.Fprintf(&instructions, "%d. Call `%s` %s\n", number, step.Tool, step.Instruction)
+		number++
+	}
+	instructions.WriteString("Only read from the cluster, do not call tools which modify resources unless asked to.")
+
+	return []*mcp.PromptMessage{
+		{Role: "user", Content: &mcp.TextContent{Text: task}},
+		{Role: "user", Content: &mcp.TextContent{Text: instructions.String()}},
+		{Role: "user", Content: &mcp.TextContent{Text: report}},
+	}
+}
@@ -0,0 +1,44 @@
+package prompts
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var ReviewNamespaceHealthPrompt = &mcp.Prompt{
+	Name:        "review-namespace-health",
+	Title:       "Review namespace health",
+	Description: "Review the health of the workloads, pods, storage and events in a namespace",
+	Arguments:   []*mcp.PromptArgument{namespaceArgument},
+}
+
+func ReviewNamespaceHealthHandler(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	slog.Debug("Prompt requested", "prompt", req.Params.Name)
+
+	namespace, err := getArgument(req, namespaceArgument)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mcp.GetPromptResult{
+		Description: fmt.Sprintf("Review the health of namespace %s", namespace),
+		Messages: runbookMessages(
+			fmt.Sprintf("Review the health of the namespace %s and find anything which is failing or degraded.", namespace),
+			[]runbookStep{
+				{Tool: "get_namespace", Instruction: fmt.Sprintf("with name %s to check it exists and is Active.", namespace)},
+				{Tool: "list_deployments", Instruction: fmt.Sprintf("with namespace %s and find deployments with unavailable replicas.", namespace)},
+				{Tool: "list_stateful_sets", Instruction: fmt.Sprintf("with namespace %s and summary enabled, and find stateful sets which are not fully ready.", namespace)},
+				{Tool: "list_daemon_sets", Instruction: fmt.Sprintf("with namespace %s and summary enabled, and find daemon sets which are not fully ready.", namespace)},
+				{Tool: "list_jobs", Instruction: fmt.Sprintf("with namespace %s and summary enabled, and find failed jobs.", namespace)},
+				{Tool: "list_pods", Instruction: fmt.Sprintf("with namespace %s and find pods which are not Running or Succeeded, or have restarting containers.", namespace)},
+				{Tool: "diagnose_pod", Instruction: "for each unhealthy pod found."},
+				{Tool: "list_persistent_volume_claims", Instruction: fmt.Sprintf("with namespace %s and find claims which are not Bound.", namespace)},
+				{Tool: "list_events", Instruction: fmt.Sprintf("with namespace %s, type Warning and since 1h to find recent warnings.", namespace)},
+			},
+			"Report an overall health verdict for the namespace, then each problem found ordered by severity with the affected resources, the evidence and a suggested fix.",
+		),
+	}, nil
+}
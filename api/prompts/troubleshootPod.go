@@ -0,0 +1,50 @@
+package prompts
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var troubleshootPodNameArgument = &mcp.PromptArgument{
+	Name:        "name",
+	Title:       "Pod name",
+	Description: "The name of the pod to troubleshoot",
+	Required:    true,
+}
+
+var TroubleshootPodPrompt = &mcp.Prompt{
+	Name:        "troubleshoot-pod",
+	Title:       "Troubleshoot pod",
+	Description: "Find out why a pod is failing, crash looping or not becoming ready",
+	Arguments:   []*mcp.PromptArgument{namespaceArgument, troubleshootPodNameArgument},
+}
+
+func TroubleshootPodHandler(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	slog.Debug("Prompt requested", "prompt", req.Params.Name)
+
+	namespace, err := getArgument(req, namespaceArgument)
+	if err != nil {
+		return nil, err
+	}
+	name, err := getArgument(req, troubleshootPodNameArgument)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mcp.GetPromptResult{
+		Description: fmt.Sprintf("Troubleshoot pod %s in namespace %s", name, namespace),
+		Messages: runbookMessages(
+			fmt.Sprintf("The pod %s in namespace %s is not working as expected. Find the root cause.", name, namespace),
+			[]runbookStep{
+				{Tool: "diagnose_pod", Instruction: fmt.Sprintf("with namespace %s and name %s to get rule-based findings for the pod.", namespace, name)},
+				{Tool: "describe_resource", Instruction: fmt.Sprintf("with kind Pod, namespace %s and name %s to see its events, owners and node conditions.", namespace, name)},
+				{Tool: "get_owner_tree", Instruction: "for the pod to find the workload which manages it."},
+				{Tool: "describe_resource", Instruction: "for any ConfigMap, Secret or PersistentVolumeClaim referenced in the findings that looks missing or unhealthy."},
+			},
+			"Report the most likely root cause first, the evidence for it, and the change needed to fix it. Mention any other problems found separately.",
+		),
+	}, nil
+}
@@ -7,9 +7,12 @@ package server
 import (
 	"log/slog"
 	"net/http"
+	"os"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
+	"github.com/cturner8/kube-mcp/config"
+	prompts "github.com/cturner8/kube-mcp/prompts"
 	tools "github.com/cturner8/kube-mcp/tools"
 )
 
@@ -19,7 +22,9 @@ func StartServer() {
 		Name:    "kube-mcp",
 		Version: "0.0.0",
 		Title:   "Kubernetes API MCP",
-	}, nil)
+	}, &mcp.ServerOptions{
+		CompletionHandler: tools.NewCompletionHandler(prompts.NameArgumentKinds),
+	})
 	// Track the active tools based on configuration
 	activeTools := []string{}
 
@@ -31,6 +36,10 @@ func StartServer() {
 		mcp.AddTool(server, tools.GetServerVersionTool, tools.GetServerVersionHandler)
 		activeTools = append(activeTools, tools.GetServerVersionTool.Name)
 	}
+	if tools.IsToolAllowed(tools.ClusterOverviewTool.Name) {
+		mcp.AddTool(server, tools.ClusterOverviewTool, tools.ClusterOverviewHandler)
+		activeTools = append(activeTools, tools.ClusterOverviewTool.Name)
+	}
 
 	// API resource tools
 
@@ -63,6 +72,10 @@ func StartServer() {
 		mcp.AddTool(server, tools.GetServiceTool, tools.GetServiceHandler)
 		activeTools = append(activeTools, tools.GetServiceTool.Name)
 	}
+	if tools.IsToolAllowed(tools.AnalyzeServiceTool.Name) {
+		mcp.AddTool(server, tools.AnalyzeServiceTool, tools.AnalyzeServiceHandler)
+		activeTools = append(activeTools, tools.AnalyzeServiceTool.Name)
+	}
 
 	// Deployments
 	if tools.IsToolAllowed(tools.ListDeploymentsTool.Name) {
@@ -74,6 +87,76 @@ func StartServer() {
 		activeTools = append(activeTools, tools.GetDeploymentTool.Name)
 	}
 
+	// StatefulSets
+	if tools.IsToolAllowed(tools.ListStatefulSetsTool.Name) {
+		mcp.AddTool(server, tools.ListStatefulSetsTool, tools.ListStatefulSetsHandler)
+		activeTools = append(activeTools, tools.ListStatefulSetsTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetStatefulSetTool.Name) {
+		mcp.AddTool(server, tools.GetStatefulSetTool, tools.GetStatefulSetHandler)
+		activeTools = append(activeTools, tools.GetStatefulSetTool.Name)
+	}
+
+	// DaemonSets
+	if tools.IsToolAllowed(tools.ListDaemonSetsTool.Name) {
+		mcp.AddTool(server, tools.ListDaemonSetsTool, tools.ListDaemonSetsHandler)
+		activeTools = append(activeTools, tools.ListDaemonSetsTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetDaemonSetTool.Name) {
+		mcp.AddTool(server, tools.GetDaemonSetTool, tools.GetDaemonSetHandler)
+		activeTools = append(activeTools, tools.GetDaemonSetTool.Name)
+	}
+
+	// ReplicaSets
+	if tools.IsToolAllowed(tools.ListReplicaSetsTool.Name) {
+		mcp.AddTool(server, tools.ListReplicaSetsTool, tools.ListReplicaSetsHandler)
+		activeTools = append(activeTools, tools.ListReplicaSetsTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetReplicaSetTool.Name) {
+		mcp.AddTool(server, tools.GetReplicaSetTool, tools.GetReplicaSetHandler)
+		activeTools = append(activeTools, tools.GetReplicaSetTool.Name)
+	}
+
+	// Jobs
+	if tools.IsToolAllowed(tools.ListJobsTool.Name) {
+		mcp.AddTool(server, tools.ListJobsTool, tools.ListJobsHandler)
+		activeTools = append(activeTools, tools.ListJobsTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetJobTool.Name) {
+		mcp.AddTool(server, tools.GetJobTool, tools.GetJobHandler)
+		activeTools = append(activeTools, tools.GetJobTool.Name)
+	}
+
+	// CronJobs
+	if tools.IsToolAllowed(tools.ListCronJobsTool.Name) {
+		mcp.AddTool(server, tools.ListCronJobsTool, tools.ListCronJobsHandler)
+		activeTools = append(activeTools, tools.ListCronJobsTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetCronJobTool.Name) {
+		mcp.AddTool(server, tools.GetCronJobTool, tools.GetCronJobHandler)
+		activeTools = append(activeTools, tools.GetCronJobTool.Name)
+	}
+
+	// HorizontalPodAutoscalers
+	if tools.IsToolAllowed(tools.ListHorizontalPodAutoscalersTool.Name) {
+		mcp.AddTool(server, tools.ListHorizontalPodAutoscalersTool, tools.ListHorizontalPodAutoscalersHandler)
+		activeTools = append(activeTools, tools.ListHorizontalPodAutoscalersTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetHorizontalPodAutoscalerTool.Name) {
+		mcp.AddTool(server, tools.GetHorizontalPodAutoscalerTool, tools.GetHorizontalPodAutoscalerHandler)
+		activeTools = append(activeTools, tools.GetHorizontalPodAutoscalerTool.Name)
+	}
+
+	// PodDisruptionBudgets
+	if tools.IsToolAllowed(tools.ListPodDisruptionBudgetsTool.Name) {
+		mcp.AddTool(server, tools.ListPodDisruptionBudgetsTool, tools.ListPodDisruptionBudgetsHandler)
+		activeTools = append(activeTools, tools.ListPodDisruptionBudgetsTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetPodDisruptionBudgetTool.Name) {
+		mcp.AddTool(server, tools.GetPodDisruptionBudgetTool, tools.GetPodDisruptionBudgetHandler)
+		activeTools = append(activeTools, tools.GetPodDisruptionBudgetTool.Name)
+	}
+
 	// Ingresses
 	if tools.IsToolAllowed(tools.ListIngressesTool.Name) {
 		mcp.AddTool(server, tools.ListIngressesTool, tools.ListIngressesHandler)
@@ -84,6 +167,60 @@ func StartServer() {
 		activeTools = append(activeTools, tools.GetIngressTool.Name)
 	}
 
+	// NetworkPolicies
+	if tools.IsToolAllowed(tools.ListNetworkPoliciesTool.Name) {
+		mcp.AddTool(server, tools.ListNetworkPoliciesTool, tools.ListNetworkPoliciesHandler)
+		activeTools = append(activeTools, tools.ListNetworkPoliciesTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetNetworkPolicyTool.Name) {
+		mcp.AddTool(server, tools.GetNetworkPolicyTool, tools.GetNetworkPolicyHandler)
+		activeTools = append(activeTools, tools.GetNetworkPolicyTool.Name)
+	}
+	if tools.IsToolAllowed(tools.CheckNetworkPolicyTool.Name) {
+		mcp.AddTool(server, tools.CheckNetworkPolicyTool, tools.CheckNetworkPolicyHandler)
+		activeTools = append(activeTools, tools.CheckNetworkPolicyTool.Name)
+	}
+
+	// EndpointSlices
+	if tools.IsToolAllowed(tools.ListEndpointSlicesTool.Name) {
+		mcp.AddTool(server, tools.ListEndpointSlicesTool, tools.ListEndpointSlicesHandler)
+		activeTools = append(activeTools, tools.ListEndpointSlicesTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetEndpointSliceTool.Name) {
+		mcp.AddTool(server, tools.GetEndpointSliceTool, tools.GetEndpointSliceHandler)
+		activeTools = append(activeTools, tools.GetEndpointSliceTool.Name)
+	}
+
+	// Gateway API
+	if tools.IsToolAllowed(tools.ListGatewaysTool.Name) {
+		mcp.AddTool(server, tools.ListGatewaysTool, tools.ListGatewaysHandler)
+		activeTools = append(activeTools, tools.ListGatewaysTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetGatewayTool.Name) {
+		mcp.AddTool(server, tools.GetGatewayTool, tools.GetGatewayHandler)
+		activeTools = append(activeTools, tools.GetGatewayTool.Name)
+	}
+	if tools.IsToolAllowed(tools.ListHTTPRoutesTool.Name) {
+		mcp.AddTool(server, tools.ListHTTPRoutesTool, tools.ListHTTPRoutesHandler)
+		activeTools = append(activeTools, tools.ListHTTPRoutesTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetHTTPRouteTool.Name) {
+		mcp.AddTool(server, tools.GetHTTPRouteTool, tools.GetHTTPRouteHandler)
+		activeTools = append(activeTools, tools.GetHTTPRouteTool.Name)
+	}
+	if tools.IsToolAllowed(tools.ListGRPCRoutesTool.Name) {
+		mcp.AddTool(server, tools.ListGRPCRoutesTool, tools.ListGRPCRoutesHandler)
+		activeTools = append(activeTools, tools.ListGRPCRoutesTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetGRPCRouteTool.Name) {
+		mcp.AddTool(server, tools.GetGRPCRouteTool, tools.GetGRPCRouteHandler)
+		activeTools = append(activeTools, tools.GetGRPCRouteTool.Name)
+	}
+	if tools.IsToolAllowed(tools.TraceRouteTool.Name) {
+		mcp.AddTool(server, tools.TraceRouteTool, tools.TraceRouteHandler)
+		activeTools = append(activeTools, tools.TraceRouteTool.Name)
+	}
+
 	// Persistent Volumes
 	if tools.IsToolAllowed(tools.ListPersistentVolumesTool.Name) {
 		mcp.AddTool(server, tools.ListPersistentVolumesTool, tools.ListPersistentVolumesHandler)
@@ -101,6 +238,62 @@ func StartServer() {
 		mcp.AddTool(server, tools.GetPersistentVolumeClaimTool, tools.GetPersistentVolumeClaimHandler)
 		activeTools = append(activeTools, tools.GetPersistentVolumeClaimTool.Name)
 	}
+	if tools.IsToolAllowed(tools.DiagnosePVCTool.Name) {
+		mcp.AddTool(server, tools.DiagnosePVCTool, tools.DiagnosePVCHandler)
+		activeTools = append(activeTools, tools.DiagnosePVCTool.Name)
+	}
+
+	// Storage
+	if tools.IsToolAllowed(tools.ListStorageClassesTool.Name) {
+		mcp.AddTool(server, tools.ListStorageClassesTool, tools.ListStorageClassesHandler)
+		activeTools = append(activeTools, tools.ListStorageClassesTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetStorageClassTool.Name) {
+		mcp.AddTool(server, tools.GetStorageClassTool, tools.GetStorageClassHandler)
+		activeTools = append(activeTools, tools.GetStorageClassTool.Name)
+	}
+	if tools.IsToolAllowed(tools.ListVolumeAttachmentsTool.Name) {
+		mcp.AddTool(server, tools.ListVolumeAttachmentsTool, tools.ListVolumeAttachmentsHandler)
+		activeTools = append(activeTools, tools.ListVolumeAttachmentsTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetVolumeAttachmentTool.Name) {
+		mcp.AddTool(server, tools.GetVolumeAttachmentTool, tools.GetVolumeAttachmentHandler)
+		activeTools = append(activeTools, tools.GetVolumeAttachmentTool.Name)
+	}
+	if tools.IsToolAllowed(tools.ListCSIDriversTool.Name) {
+		mcp.AddTool(server, tools.ListCSIDriversTool, tools.ListCSIDriversHandler)
+		activeTools = append(activeTools, tools.ListCSIDriversTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetCSIDriverTool.Name) {
+		mcp.AddTool(server, tools.GetCSIDriverTool, tools.GetCSIDriverHandler)
+		activeTools = append(activeTools, tools.GetCSIDriverTool.Name)
+	}
+
+	// Resource quotas and capacity
+	if tools.IsToolAllowed(tools.ListResourceQuotasTool.Name) {
+		mcp.AddTool(server, tools.ListResourceQuotasTool, tools.ListResourceQuotasHandler)
+		activeTools = append(activeTools, tools.ListResourceQuotasTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetResourceQuotaTool.Name) {
+		mcp.AddTool(server, tools.GetResourceQuotaTool, tools.GetResourceQuotaHandler)
+		activeTools = append(activeTools, tools.GetResourceQuotaTool.Name)
+	}
+	if tools.IsToolAllowed(tools.ListLimitRangesTool.Name) {
+		mcp.AddTool(server, tools.ListLimitRangesTool, tools.ListLimitRangesHandler)
+		activeTools = append(activeTools, tools.ListLimitRangesTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetLimitRangeTool.Name) {
+		mcp.AddTool(server, tools.GetLimitRangeTool, tools.GetLimitRangeHandler)
+		activeTools = append(activeTools, tools.GetLimitRangeTool.Name)
+	}
+	if tools.IsToolAllowed(tools.NamespaceCapacityTool.Name) {
+		mcp.AddTool(server, tools.NamespaceCapacityTool, tools.NamespaceCapacityHandler)
+		activeTools = append(activeTools, tools.NamespaceCapacityTool.Name)
+	}
+	if tools.IsToolAllowed(tools.NodeAllocationTool.Name) {
+		mcp.AddTool(server, tools.NodeAllocationTool, tools.NodeAllocationHandler)
+		activeTools = append(activeTools, tools.NodeAllocationTool.Name)
+	}
 
 	// Pods
 	if tools.IsToolAllowed(tools.ListPodsTool.Name) {
@@ -111,6 +304,10 @@ func StartServer() {
 		mcp.AddTool(server, tools.GetPodTool, tools.GetPodHandler)
 		activeTools = append(activeTools, tools.GetPodTool.Name)
 	}
+	if tools.IsToolAllowed(tools.DiagnosePodTool.Name) {
+		mcp.AddTool(server, tools.DiagnosePodTool, tools.DiagnosePodHandler)
+		activeTools = append(activeTools, tools.DiagnosePodTool.Name)
+	}
 
 	// Events
 	if tools.IsToolAllowed(tools.ListEventsTool.Name) {
@@ -118,6 +315,24 @@ func StartServer() {
 		activeTools = append(activeTools, tools.ListEventsTool.Name)
 	}
 
+	// Resources
+	if tools.IsToolAllowed(tools.DescribeResourceTool.Name) {
+		mcp.AddTool(server, tools.DescribeResourceTool, tools.DescribeResourceHandler)
+		activeTools = append(activeTools, tools.DescribeResourceTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetOwnerTreeTool.Name) {
+		mcp.AddTool(server, tools.GetOwnerTreeTool, tools.GetOwnerTreeHandler)
+		activeTools = append(activeTools, tools.GetOwnerTreeTool.Name)
+	}
+	if tools.IsToolAllowed(tools.ListAPIResourcesTool.Name) {
+		mcp.AddTool(server, tools.ListAPIResourcesTool, tools.ListAPIResourcesHandler)
+		activeTools = append(activeTools, tools.ListAPIResourcesTool.Name)
+	}
+	if tools.IsToolAllowed(tools.ExplainResourceTool.Name) {
+		mcp.AddTool(server, tools.ExplainResourceTool, tools.ExplainResourceHandler)
+		activeTools = append(activeTools, tools.ExplainResourceTool.Name)
+	}
+
 	// ConfigMaps
 	if tools.IsToolAllowed(tools.ListConfigMapsTool.Name) {
 		mcp.AddTool(server, tools.ListConfigMapsTool, tools.ListConfigMapsHandler)
@@ -138,7 +353,150 @@ func StartServer() {
 		activeTools = append(activeTools, tools.GetSecretTool.Name)
 	}
 
-	slog.Info("Active tools", "count", len(activeTools))
+	// Helm
+	if tools.IsToolAllowed(tools.ListHelmReleasesTool.Name) {
+		mcp.AddTool(server, tools.ListHelmReleasesTool, tools.ListHelmReleasesHandler)
+		activeTools = append(activeTools, tools.ListHelmReleasesTool.Name)
+	}
+	if tools.IsToolAllowed(tools.GetHelmReleaseTool.Name) {
+		mcp.AddTool(server, tools.GetHelmReleaseTool, tools.GetHelmReleaseHandler)
+		activeTools = append(activeTools, tools.GetHelmReleaseTool.Name)
+	}
+
+	// Metrics
+	if tools.IsToolAllowed(tools.TopPodsTool.Name) {
+		mcp.AddTool(server, tools.TopPodsTool, tools.TopPodsHandler)
+		activeTools = append(activeTools, tools.TopPodsTool.Name)
+	}
+	if tools.IsToolAllowed(tools.TopNodesTool.Name) {
+		mcp.AddTool(server, tools.TopNodesTool, tools.TopNodesHandler)
+		activeTools = append(activeTools, tools.TopNodesTool.Name)
+	}
+
+	// RBAC
+	if tools.IsToolAllowed(tools.ListRolesTool.Name) {
+		mcp.AddTool(server, tools.ListRolesTool, tools.ListRolesHandler)
+		activeTools = append(activeTools, tools.ListRolesTool.Name)
+	}
+	if tools.IsToolAllowed(tools.ListRoleBindingsTool.Name) {
+		mcp.AddTool(server, tools.ListRoleBindingsTool, tools.ListRoleBindingsHandler)
+		activeTools = append(activeTools, tools.ListRoleBindingsTool.Name)
+	}
+	if tools.IsToolAllowed(tools.ListClusterRolesTool.Name) {
+		mcp.AddTool(server, tools.ListClusterRolesTool, tools.ListClusterRolesHandler)
+		activeTools = append(activeTools, tools.ListClusterRolesTool.Name)
+	}
+	if tools.IsToolAllowed(tools.ListClusterRoleBindingsTool.Name) {
+		mcp.AddTool(server, tools.ListClusterRoleBindingsTool, tools.ListClusterRoleBindingsHandler)
+		activeTools = append(activeTools, tools.ListClusterRoleBindingsTool.Name)
+	}
+	if tools.IsToolAllowed(tools.CanITool.Name) {
+		mcp.AddTool(server, tools.CanITool, tools.CanIHandler)
+		activeTools = append(activeTools, tools.CanITool.Name)
+	}
+	if tools.IsToolAllowed(tools.WhoCanTool.Name) {
+		mcp.AddTool(server, tools.WhoCanTool, tools.WhoCanHandler)
+		activeTools = append(activeTools, tools.WhoCanTool.Name)
+	}
+
+	// Images and security
+	if tools.IsToolAllowed(tools.ImageInventoryTool.Name) {
+		mcp.AddTool(server, tools.ImageInventoryTool, tools.ImageInventoryHandler)
+		activeTools = append(activeTools, tools.ImageInventoryTool.Name)
+	}
+	if tools.IsToolAllowed(tools.SecurityPostureTool.Name) {
+		mcp.AddTool(server, tools.SecurityPostureTool, tools.SecurityPostureHandler)
+		activeTools = append(activeTools, tools.SecurityPostureTool.Name)
+	}
+
+	// Manifests, applies are dry run unless write mode is enabled
+	if tools.IsToolAllowed(tools.ApplyManifestTool.Name) {
+		mcp.AddTool(server, tools.ApplyManifestTool, tools.ApplyManifestHandler)
+		activeTools = append(activeTools, tools.ApplyManifestTool.Name)
+	}
+	if tools.IsToolAllowed(tools.DiffManifestTool.Name) {
+		mcp.AddTool(server, tools.DiffManifestTool, tools.DiffManifestHandler)
+		activeTools = append(activeTools, tools.DiffManifestTool.Name)
+	}
+
+	// Write tools, only available when write mode is enabled
+
+	// Workloads
+	if tools.IsWriteToolAllowed(tools.ScaleWorkloadTool.Name) {
+		mcp.AddTool(server, tools.ScaleWorkloadTool, tools.ScaleWorkloadHandler)
+		activeTools = append(activeTools, tools.ScaleWorkloadTool.Name)
+	}
+	if tools.IsWriteToolAllowed(tools.RestartWorkloadTool.Name) {
+		mcp.AddTool(server, tools.RestartWorkloadTool, tools.RestartWorkloadHandler)
+		activeTools = append(activeTools, tools.RestartWorkloadTool.Name)
+	}
+	if tools.IsWriteToolAllowed(tools.RollbackDeploymentTool.Name) {
+		mcp.AddTool(server, tools.RollbackDeploymentTool, tools.RollbackDeploymentHandler)
+		activeTools = append(activeTools, tools.RollbackDeploymentTool.Name)
+	}
+
+	// CronJobs
+	if tools.IsWriteToolAllowed(tools.TriggerCronJobTool.Name) {
+		mcp.AddTool(server, tools.TriggerCronJobTool, tools.TriggerCronJobHandler)
+		activeTools = append(activeTools, tools.TriggerCronJobTool.Name)
+	}
+
+	// Pods
+	if tools.IsWriteToolAllowed(tools.DeletePodTool.Name) {
+		mcp.AddTool(server, tools.DeletePodTool, tools.DeletePodHandler)
+		activeTools = append(activeTools, tools.DeletePodTool.Name)
+	}
+	if tools.IsWriteToolAllowed(tools.EvictPodTool.Name) {
+		mcp.AddTool(server, tools.EvictPodTool, tools.EvictPodHandler)
+		activeTools = append(activeTools, tools.EvictPodTool.Name)
+	}
+
+	// Nodes
+	if tools.IsWriteToolAllowed(tools.CordonNodeTool.Name) {
+		mcp.AddTool(server, tools.CordonNodeTool, tools.CordonNodeHandler)
+		activeTools = append(activeTools, tools.CordonNodeTool.Name)
+	}
+	if tools.IsWriteToolAllowed(tools.UncordonNodeTool.Name) {
+		mcp.AddTool(server, tools.UncordonNodeTool, tools.UncordonNodeHandler)
+		activeTools = append(activeTools, tools.UncordonNodeTool.Name)
+	}
+	if tools.IsWriteToolAllowed(tools.DrainNodeTool.Name) {
+		mcp.AddTool(server, tools.DrainNodeTool, tools.DrainNodeHandler)
+		activeTools = append(activeTools, tools.DrainNodeTool.Name)
+	}
+
+	slog.Info("Active tools", "count", len(activeTools), "writeMode", config.ServerConfig.WriteMode)
+
+	// Add the resource templates, which share the describe_resource lookup
+	if tools.IsToolAllowed(tools.DescribeResourceTool.Name) {
+		server.AddResourceTemplate(tools.NamespacedResourceTemplate, tools.ReadKubeResourceHandler)
+		server.AddResourceTemplate(tools.ClusterResourceTemplate, tools.ReadKubeResourceHandler)
+	}
+
+	// Add the prompts
+	server.AddPrompt(prompts.TroubleshootPodPrompt, prompts.TroubleshootPodHandler)
+	server.AddPrompt(prompts.InvestigateNodePressurePrompt, prompts.InvestigateNodePressureHandler)
+	server.AddPrompt(prompts.ReviewNamespaceHealthPrompt, prompts.ReviewNamespaceHealthHandler)
+	server.AddPrompt(prompts.ExplainFailedRolloutPrompt, prompts.ExplainFailedRolloutHandler)
+
+	// Custom prompts replace built-in prompts with the same name
+	if config.ServerConfig.PromptsDir != "" {
+		customPrompts, err := prompts.LoadCustomPrompts(config.ServerConfig.PromptsDir)
+		if err != nil {
+			slog.Error("Unable to load custom prompts", "dir", config.ServerConfig.PromptsDir, "error", err)
+			os.Exit(1)
+		}
+		for _, prompt := range customPrompts {
+			server.AddPrompt(prompt.Prompt, prompt.Handler)
+			// The kind of a replaced built-in prompt no longer applies
+			if prompt.NameKind != "" {
+				prompts.NameArgumentKinds[prompt.Prompt.Name] = prompt.NameKind
+			} else {
+				delete(prompts.NameArgumentKinds, prompt.Prompt.Name)
+			}
+		}
+		slog.Info("Loaded custom prompts", "count", len(customPrompts), "dir", config.ServerConfig.PromptsDir)
+	}
 
 	// Create the streamable HTTP handler.
 	handler := mcp.NewStreamableHTTPHandler(func(req *http.Request) *mcp.Server {
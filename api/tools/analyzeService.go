@@ -0,0 +1,262 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var AnalyzeServiceTool = &mcp.Tool{
+	Name:        "analyze_service",
+	Description: "Analyse why a service in the Kubernetes cluster has no ready endpoints. Checks whether the selector matches any pods, whether the pods are ready, and whether the target ports are exposed by the containers",
+}
+
+type AnalyzeServiceToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the service"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the service"`
+}
+
+type ServicePodSummary struct {
+	Name   string `json:"name"`
+	Ready  bool   `json:"ready"`
+	Status string `json:"status"`
+	IP     string `json:"ip,omitempty"`
+}
+
+type AnalyzeServiceResult struct {
+	Name           string                 `json:"name"`
+	Namespace      string                 `json:"namespace"`
+	Type           corev1.ServiceType     `json:"type"`
+	Selector       map[string]string      `json:"selector,omitempty"`
+	ReadyEndpoints int                    `json:"readyEndpoints"`
+	Healthy        bool                   `json:"healthy"`
+	Pods           []ServicePodSummary    `json:"pods"`
+	EndpointSlices []EndpointSliceSummary `json:"endpointSlices"`
+	Findings       []Finding              `json:"findings"`
+}
+
+// partialSelectorMatches finds pods which match some, but not all, of a
+// selector's labels, which usually indicates a typo in the selector or labels.
+func partialSelectorMatches(selector map[string]string, pods []corev1.Pod) []string {
+	evidence := []string{}
+	for _, pod := range pods {
+		matched := []string{}
+		mismatched := []string{}
+		for key, value := range selector {
+			if pod.Labels[key] == value {
+				matched = append(matched, key)
+			} else {
+				mismatched = append(mismatched, fmt.Sprintf("%s=%q (pod has %q)", key, value, pod.Labels[key]))
+			}
+		}
+		if len(matched) > 0 {
+			slices.Sort(mismatched)
+			evidence = append(evidence, fmt.Sprintf("pod %s matches %d/%d selector labels, differs on %s", pod.Name, len(matched), len(selector), strings.Join(mismatched, ", ")))
+		}
+	}
+	return evidence
+}
+
+// containerPortFor resolves a service target port against a pod's containers,
+// returning false if the pod does not expose it.
+func containerPortFor(pod *corev1.Pod, targetPort intstr.IntOrString, protocol corev1.Protocol) (int32, bool) {
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			protocolMatches := port.Protocol == protocol || (port.Protocol == "" && protocol == corev1.ProtocolTCP)
+			if !protocolMatches {
+				continue
+			}
+			if targetPort.Type == intstr.String && port.Name == targetPort.StrVal {
+				return port.ContainerPort, true
+			}
+			if targetPort.Type == intstr.Int && port.ContainerPort == targetPort.IntVal {
+				return port.ContainerPort, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func analyzeServicePorts(service *corev1.Service, pods []corev1.Pod) []Finding {
+	findings := []Finding{}
+	if len(pods) == 0 {
+		return findings
+	}
+	for _, servicePort := range service.Spec.Ports {
+		targetPort := servicePort.TargetPort
+		if targetPort.Type == intstr.Int && targetPort.IntVal == 0 {
+			targetPort = intstr.FromInt32(servicePort.Port)
+		}
+		protocol := servicePort.Protocol
+		if protocol == "" {
+			protocol = corev1.ProtocolTCP
+		}
+
+		missing := []string{}
+		for i := range pods {
+			if _, ok := containerPortFor(&pods[i], targetPort, protocol); !ok {
+				missing = append(missing, pods[i].Name)
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		finding := Finding{
+			Type:     "TargetPortNotExposed",
+			Evidence: []string{fmt.Sprintf("pods without a matching container port: %s", strings.Join(missing, ", "))},
+		}
+		if targetPort.Type == intstr.String {
+			// Named target ports must resolve to a container port, otherwise the pod is left out of the endpoints for the port.
+			finding.Severity = severityCritical
+			finding.Message = fmt.Sprintf("Service port %d targets the named port %q, which is not defined by %d/%d selected pods", servicePort.Port, targetPort.StrVal, len(missing), len(pods))
+		} else {
+			// Numeric target ports are used even if not declared, but the container may not be listening on them.
+			finding.Severity = severityWarning
+			finding.Message = fmt.Sprintf("Service port %d targets port %d/%s, which is not declared as a container port by %d/%d selected pods. Check the container listens on it", servicePort.Port, targetPort.IntVal, protocol, len(missing), len(pods))
+		}
+		findings = append(findings, finding)
+	}
+	return findings
+}
+
+func AnalyzeServiceHandler(ctx context.Context, req *mcp.CallToolRequest, params AnalyzeServiceToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	service, err := kubernetesApiClient.CoreV1().Services(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get service from Kubernetes API", "tool", req.Params.Name, "service", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	result := AnalyzeServiceResult{
+		Name:           service.Name,
+		Namespace:      service.Namespace,
+		Type:           service.Spec.Type,
+		Selector:       service.Spec.Selector,
+		Pods:           []ServicePodSummary{},
+		EndpointSlices: []EndpointSliceSummary{},
+		Findings:       []Finding{},
+	}
+
+	endpointSlices, err := kubernetesApiClient.DiscoveryV1().EndpointSlices(service.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", discoveryv1.LabelServiceName, service.Name),
+	})
+	if err != nil {
+		slog.Error("Failed to list endpoint slices from Kubernetes API", "tool", req.Params.Name, "service", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+	for i := range endpointSlices.Items {
+		summary := summariseEndpointSlice(&endpointSlices.Items[i])
+		for _, endpoint := range summary.Endpoints {
+			if endpoint.Ready {
+				result.ReadyEndpoints++
+			}
+		}
+		result.EndpointSlices = append(result.EndpointSlices, summary)
+	}
+
+	switch {
+	case service.Spec.Type == corev1.ServiceTypeExternalName:
+		result.Findings = append(result.Findings, Finding{
+			Type:     "ExternalName",
+			Severity: severityInfo,
+			Message:  fmt.Sprintf("ExternalName services have no endpoints, DNS resolves to %s", service.Spec.ExternalName),
+		})
+	case len(service.Spec.Selector) == 0:
+		finding := Finding{
+			Type:     "NoSelector",
+			Severity: severityInfo,
+			Message:  "The service has no selector, its endpoints are managed manually or by another controller",
+		}
+		if len(endpointSlices.Items) == 0 {
+			finding.Severity = severityCritical
+			finding.Message += " and none exist"
+		}
+		result.Findings = append(result.Findings, finding)
+	default:
+		pods, err := kubernetesApiClient.CoreV1().Pods(service.Namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			slog.Error("Failed to list pods from Kubernetes API", "tool", req.Params.Name, "namespace", params.Namespace, "error", err)
+			return nil, nil, err
+		}
+
+		selector := labels.SelectorFromSet(service.Spec.Selector)
+		selected := []corev1.Pod{}
+		for _, pod := range pods.Items {
+			if selector.Matches(labels.Set(pod.Labels)) {
+				selected = append(selected, pod)
+			}
+		}
+
+		if len(selected) == 0 {
+			result.Findings = append(result.Findings, Finding{
+				Type:     "SelectorMatchesNoPods",
+				Severity: severityCritical,
+				Message:  fmt.Sprintf("The selector %s matches no pods in namespace %s", selector.String(), service.Namespace),
+				Evidence: partialSelectorMatches(service.Spec.Selector, pods.Items),
+			})
+		}
+
+		notReady := []string{}
+		for i := range selected {
+			pod := &selected[i]
+			summary := ServicePodSummary{
+				Name:   pod.Name,
+				Ready:  isPodReady(pod),
+				Status: string(pod.Status.Phase),
+				IP:     pod.Status.PodIP,
+			}
+			if reason := unhealthyPodReason(pod); reason != "" {
+				summary.Status = reason
+			}
+			if pod.DeletionTimestamp != nil {
+				summary.Status = "Terminating"
+			}
+			if !summary.Ready && !service.Spec.PublishNotReadyAddresses {
+				notReady = append(notReady, fmt.Sprintf("pod %s is not ready: %s", pod.Name, summary.Status))
+			}
+			result.Pods = append(result.Pods, summary)
+		}
+		if len(notReady) > 0 {
+			severity := severityWarning
+			if len(notReady) == len(selected) {
+				severity = severityCritical
+			}
+			result.Findings = append(result.Findings, Finding{
+				Type:     "PodsNotReady",
+				Severity: severity,
+				Message:  fmt.Sprintf("%d/%d selected pods are not ready and are excluded from the ready endpoints. Use diagnose_pod to find out why", len(notReady), len(selected)),
+				Evidence: notReady,
+			})
+		}
+
+		result.Findings = append(result.Findings, analyzeServicePorts(service, selected)...)
+	}
+
+	sortFindings(result.Findings)
+	result.Healthy = service.Spec.Type == corev1.ServiceTypeExternalName || (result.ReadyEndpoints > 0 && !hasProblemFindings(result.Findings))
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal service analysis", "service", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,234 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/cturner8/kube-mcp/config"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+)
+
+// applyFieldManager is the field manager recorded against server-side applied fields.
+const applyFieldManager = "kube-mcp"
+
+var ApplyManifestTool = &mcp.Tool{
+	Name:        "apply_manifest",
+	Description: "Server-side apply a multi-document YAML manifest to the Kubernetes cluster. Performs a dry run by default and returns a diff per object",
+}
+
+type ApplyManifestToolParams struct {
+	Manifest  string  `json:"manifest" jsonschema:"The YAML or JSON manifest to apply, may contain multiple documents"`
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace for namespaced objects which do not specify one, defaults to 'default'"`
+	DryRun    *bool   `json:"dryRun,omitempty" jsonschema:"Whether to perform a server-side dry run only, defaults to true"`
+	Confirm   bool    `json:"confirm,omitempty" jsonschema:"Explicit confirmation required to apply the manifest when dryRun is false"`
+}
+
+type ApplyManifestObjectResult struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace,omitempty"`
+	Action     string `json:"action,omitempty"`
+	Diff       string `json:"diff,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+type ApplyManifestResult struct {
+	DryRun  bool                        `json:"dryRun"`
+	Objects []ApplyManifestObjectResult `json:"objects"`
+}
+
+// getApplyTarget resolves the resource of an object to be applied, defaulting
+// its namespace if it is namespaced, and gets the live object if it exists.
+func getApplyTarget(ctx context.Context, object *unstructured.Unstructured, defaultNamespace string) (*meta.RESTMapping, dynamic.ResourceInterface, *unstructured.Unstructured, error) {
+	mapping, err := resolveRESTMapping(object.GroupVersionKind())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if object.GetNamespace() == "" {
+			object.SetNamespace(defaultNamespace)
+		}
+	} else {
+		object.SetNamespace("")
+	}
+
+	resource := resourceInterfaceFor(mapping, object.GetNamespace())
+
+	live, err := resource.Get(ctx, object.GetName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return mapping, resource, nil, nil
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return mapping, resource, live, nil
+}
+
+// applyPermitted checks whether the server may server-side apply an object,
+// which needs patch, and create when the object does not exist. Dry runs are
+// authorised the same way, so they need these permissions too.
+func applyPermitted(ctx context.Context, mapping *meta.RESTMapping, object *unstructured.Unstructured, exists bool) (bool, error) {
+	verbs := []string{"patch"}
+	if !exists {
+		verbs = append(verbs, "create")
+	}
+	for _, verb := range verbs {
+		review, err := kubernetesApiClient.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Verb:      verb,
+					Group:     mapping.Resource.Group,
+					Resource:  mapping.Resource.Resource,
+					Namespace: object.GetNamespace(),
+					Name:      object.GetName(),
+				},
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return false, err
+		}
+		if !review.Status.Allowed {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// applyObject server-side applies a single object, returning the outcome and
+// a diff between the live object and the applied result.
+func applyObject(ctx context.Context, object *unstructured.Unstructured, defaultNamespace string, dryRun bool) ApplyManifestObjectResult {
+	result := ApplyManifestObjectResult{
+		APIVersion: object.GetAPIVersion(),
+		Kind:       object.GetKind(),
+		Name:       object.GetName(),
+	}
+
+	mapping, resource, live, err := getApplyTarget(ctx, object, defaultNamespace)
+	result.Namespace = object.GetNamespace()
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	options := metav1.ApplyOptions{FieldManager: applyFieldManager}
+	if dryRun {
+		// Fields owned by other managers, e.g. kubectl or Helm, would conflict.
+		// Nothing is persisted by a dry run, so conflicts are forced as kubectl
+		// diff --server-side --force-conflicts does.
+		options.DryRun = []string{metav1.DryRunAll}
+		options.Force = true
+
+		permitted, err := applyPermitted(ctx, mapping, object, live != nil)
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+		if !permitted {
+			result.Error = fmt.Sprintf("a dry run apply needs patch and create permission on %s, use diff_manifest to diff without them", mapping.Resource.Resource)
+			return result
+		}
+	}
+
+	applied, err := resource.Apply(ctx, object.GetName(), object, options)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	diff, err := unifiedObjectDiff(fmt.Sprintf("%s/%s", mapping.Resource.Resource, object.GetName()), live, applied)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Diff = diff
+	switch {
+	case live == nil:
+		result.Action = "created"
+	case diff == "":
+		result.Action = "unchanged"
+	default:
+		result.Action = "configured"
+	}
+	return result
+}
+
+func ApplyManifestHandler(ctx context.Context, req *mcp.CallToolRequest, params ApplyManifestToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	dryRun := true
+	if params.DryRun != nil {
+		dryRun = *params.DryRun
+	}
+	if !dryRun {
+		if !config.ServerConfig.WriteMode {
+			return nil, nil, errors.New("write mode is not enabled, only dry run applies are permitted")
+		}
+		if !params.Confirm {
+			return nil, nil, errors.New("applying a manifest requires explicit confirmation, set confirm to true")
+		}
+	}
+
+	namespace := "default"
+	if params.Namespace != nil && *params.Namespace != "" {
+		namespace = *params.Namespace
+	}
+
+	objects, err := decodeManifest(params.Manifest)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(objects) == 0 {
+		return nil, nil, errors.New("manifest does not contain any objects")
+	}
+
+	if !dryRun {
+		descriptions := []string{}
+		for _, object := range objects {
+			descriptions = append(descriptions, fmt.Sprintf("%s %s", object.GetKind(), object.GetName()))
+		}
+		action := fmt.Sprintf("apply %s (default namespace %s)", strings.Join(descriptions, ", "), namespace)
+		if err := confirmAction(ctx, req, action); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	result := ApplyManifestResult{
+		DryRun:  dryRun,
+		Objects: []ApplyManifestObjectResult{},
+	}
+	for _, object := range objects {
+		objectResult := applyObject(ctx, object, namespace, dryRun)
+		if objectResult.Error != "" {
+			slog.Error("Failed to apply manifest object", "tool", req.Params.Name, "kind", objectResult.Kind, "name", objectResult.Name, "namespace", objectResult.Namespace, "dryRun", dryRun, "error", objectResult.Error)
+		} else if !dryRun {
+			slog.Info("Manifest object applied", "kind", objectResult.Kind, "name", objectResult.Name, "namespace", objectResult.Namespace, "action", objectResult.Action)
+		}
+		result.Objects = append(result.Objects, objectResult)
+	}
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,124 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var CanITool = &mcp.Tool{
+	Name:        "can_i",
+	Description: "Check whether an action is allowed in the Kubernetes cluster, similar to kubectl auth can-i. Checks the MCP server's own permissions unless a user or service account is given",
+}
+
+type CanIToolParams struct {
+	Verb           string   `json:"verb" jsonschema:"The verb to check, e.g. get, list, create, delete or *"`
+	Resource       string   `json:"resource" jsonschema:"The resource to check, e.g. pods, deployments or pods/log for a subresource"`
+	Group          *string  `json:"group,omitempty" jsonschema:"The API group of the resource, resolved from the resource when not given"`
+	Namespace      *string  `json:"namespace,omitempty" jsonschema:"The namespace to check, defaults to all namespaces"`
+	Name           *string  `json:"name,omitempty" jsonschema:"The name of a specific object to check"`
+	User           *string  `json:"user,omitempty" jsonschema:"Check the permissions of this user instead of the MCP server"`
+	Groups         []string `json:"groups,omitempty" jsonschema:"The groups of the user to check"`
+	ServiceAccount *string  `json:"serviceAccount,omitempty" jsonschema:"Check the permissions of this service account instead of the MCP server, as namespace/name"`
+}
+
+type CanIResult struct {
+	Allowed         bool           `json:"allowed"`
+	Denied          bool           `json:"denied,omitempty"`
+	Reason          string         `json:"reason,omitempty"`
+	EvaluationError string         `json:"evaluationError,omitempty"`
+	Subject         string         `json:"subject"`
+	Verb            string         `json:"verb"`
+	Resource        AccessResource `json:"resource"`
+	Namespace       string         `json:"namespace,omitempty"`
+	Name            string         `json:"name,omitempty"`
+}
+
+func CanIHandler(ctx context.Context, req *mcp.CallToolRequest, params CanIToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	resource := resolveAccessResource(params.Resource, params.Group)
+	attributes := &authorizationv1.ResourceAttributes{
+		Verb:        params.Verb,
+		Group:       resource.Group,
+		Resource:    resource.Resource,
+		Subresource: resource.Subresource,
+	}
+	if params.Namespace != nil {
+		attributes.Namespace = *params.Namespace
+	}
+	if params.Name != nil {
+		attributes.Name = *params.Name
+	}
+
+	result := CanIResult{
+		Verb:      params.Verb,
+		Resource:  resource,
+		Namespace: attributes.Namespace,
+		Name:      attributes.Name,
+	}
+
+	user := ""
+	if params.User != nil {
+		user = *params.User
+	}
+	groups := params.Groups
+	if params.ServiceAccount != nil {
+		namespace, name, found := strings.Cut(*params.ServiceAccount, "/")
+		if !found {
+			return nil, nil, fmt.Errorf("service account %q must be given as namespace/name", *params.ServiceAccount)
+		}
+		user = serviceAccountUsername(namespace, name)
+		groups = append(groups, serviceAccountGroups(namespace)...)
+	}
+
+	var status authorizationv1.SubjectAccessReviewStatus
+	if user == "" {
+		review, err := kubernetesApiClient.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: attributes},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			slog.Error("Failed to create self subject access review", "tool", req.Params.Name, "error", err)
+			return nil, nil, err
+		}
+		result.Subject = "self"
+		status = review.Status
+	} else {
+		review, err := kubernetesApiClient.AuthorizationV1().SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				ResourceAttributes: attributes,
+				User:               user,
+				Groups:             groups,
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			slog.Error("Failed to create subject access review", "tool", req.Params.Name, "user", user, "error", err)
+			return nil, nil, err
+		}
+		result.Subject = user
+		status = review.Status
+	}
+	result.Allowed = status.Allowed
+	result.Denied = status.Denied
+	result.Reason = status.Reason
+	result.EvaluationError = status.EvaluationError
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal access review", "user", user, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
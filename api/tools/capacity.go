@@ -0,0 +1,84 @@
+package tools
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ResourceAllocation compares the requests and limits of pods with the capacity available to them.
+type ResourceAllocation struct {
+	Capacity         string `json:"capacity,omitempty"`
+	Requests         string `json:"requests"`
+	Limits           string `json:"limits,omitempty"`
+	PercentRequested *int64 `json:"percentRequested,omitempty"`
+	PercentLimited   *int64 `json:"percentLimited,omitempty"`
+}
+
+// isPodTerminated checks whether a pod has finished, so no longer holds its requested resources.
+func isPodTerminated(pod *corev1.Pod) bool {
+	return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+}
+
+// maxResourceList raises the quantities in a total to those in a list where they are larger.
+func maxResourceList(total corev1.ResourceList, list corev1.ResourceList) {
+	for name, quantity := range list {
+		if current, ok := total[name]; !ok || quantity.Cmp(current) > 0 {
+			total[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+// podResourceTotals calculates the effective requests and limits of a pod, the
+// same way as the scheduler and kubectl describe node. Init containers run
+// before the app containers, so only the largest counts, while sidecar init
+// containers keep running and are added to everything started after them.
+func podResourceTotals(pod *corev1.Pod) (corev1.ResourceList, corev1.ResourceList) {
+	requests, limits := corev1.ResourceList{}, corev1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		addResourceList(requests, container.Resources.Requests)
+		addResourceList(limits, container.Resources.Limits)
+	}
+
+	sidecarRequests, sidecarLimits := corev1.ResourceList{}, corev1.ResourceList{}
+	initRequests, initLimits := corev1.ResourceList{}, corev1.ResourceList{}
+	for _, container := range pod.Spec.InitContainers {
+		if container.RestartPolicy != nil && *container.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+			addResourceList(requests, container.Resources.Requests)
+			addResourceList(limits, container.Resources.Limits)
+			addResourceList(sidecarRequests, container.Resources.Requests)
+			addResourceList(sidecarLimits, container.Resources.Limits)
+			continue
+		}
+		containerRequests, containerLimits := corev1.ResourceList{}, corev1.ResourceList{}
+		addResourceList(containerRequests, container.Resources.Requests)
+		addResourceList(containerRequests, sidecarRequests)
+		addResourceList(containerLimits, container.Resources.Limits)
+		addResourceList(containerLimits, sidecarLimits)
+		maxResourceList(initRequests, containerRequests)
+		maxResourceList(initLimits, containerLimits)
+	}
+	maxResourceList(requests, initRequests)
+	maxResourceList(limits, initLimits)
+
+	addResourceList(requests, pod.Spec.Overhead)
+	if len(limits) > 0 {
+		addResourceList(limits, pod.Spec.Overhead)
+	}
+	return requests, limits
+}
+
+// summariseResourceAllocation compares the requests and limits of a resource with its capacity.
+func summariseResourceAllocation(name corev1.ResourceName, capacity *resource.Quantity, requests corev1.ResourceList, limits corev1.ResourceList) ResourceAllocation {
+	allocation := ResourceAllocation{Requests: formatQuantity(name, requests[name])}
+	if limit, ok := limits[name]; ok {
+		allocation.Limits = formatQuantity(name, limit)
+	}
+	if capacity != nil {
+		allocation.Capacity = formatQuantity(name, *capacity)
+		allocation.PercentRequested = percentOf(requests[name], *capacity)
+		if _, ok := limits[name]; ok {
+			allocation.PercentLimited = percentOf(limits[name], *capacity)
+		}
+	}
+	return allocation
+}
@@ -0,0 +1,293 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var CheckNetworkPolicyTool = &mcp.Tool{
+	Name:        "check_network_policy",
+	Description: "Check whether the network policies in the Kubernetes cluster allow traffic from one pod to another on a port. Evaluates the egress policies selecting the source pod and the ingress policies selecting the destination pod",
+}
+
+type CheckNetworkPolicyToolParams struct {
+	SourceNamespace      string  `json:"sourceNamespace" jsonschema:"The namespace of the source pod"`
+	SourcePod            string  `json:"sourcePod" jsonschema:"The name of the source pod"`
+	DestinationNamespace string  `json:"destinationNamespace" jsonschema:"The namespace of the destination pod"`
+	DestinationPod       string  `json:"destinationPod" jsonschema:"The name of the destination pod"`
+	Port                 int32   `json:"port" jsonschema:"The destination port"`
+	Protocol             *string `json:"protocol,omitempty" jsonschema:"The protocol: TCP, UDP or SCTP. Defaults to TCP"`
+}
+
+type NetworkPolicyDirectionResult struct {
+	// Isolated is true when at least one policy selects the pod for this direction.
+	Isolated  bool     `json:"isolated"`
+	Allowed   bool     `json:"allowed"`
+	Policies  []string `json:"policies"`
+	AllowedBy []string `json:"allowedBy"`
+}
+
+type CheckNetworkPolicyResult struct {
+	Allowed bool                         `json:"allowed"`
+	Egress  NetworkPolicyDirectionResult `json:"egress"`
+	Ingress NetworkPolicyDirectionResult `json:"ingress"`
+	Notes   []string                     `json:"notes"`
+}
+
+// networkPeer is the pod on the other side of a policy rule.
+type networkPeer struct {
+	pod       *corev1.Pod
+	namespace *corev1.Namespace
+}
+
+func getNetworkPeer(ctx context.Context, namespace string, name string) (*networkPeer, error) {
+	pod, err := kubernetesApiClient.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	podNamespace, err := kubernetesApiClient.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &networkPeer{pod: pod, namespace: podNamespace}, nil
+}
+
+// policyAppliesTo checks whether a network policy isolates a pod in the given direction.
+func policyAppliesTo(policy *networkingv1.NetworkPolicy, pod *corev1.Pod, policyType networkingv1.PolicyType) (bool, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.PodSelector)
+	if err != nil {
+		return false, err
+	}
+	if !selector.Matches(labels.Set(pod.Labels)) {
+		return false, nil
+	}
+
+	// Policies without policy types always apply to ingress, and to egress if they have egress rules.
+	if len(policy.Spec.PolicyTypes) == 0 {
+		return policyType == networkingv1.PolicyTypeIngress || len(policy.Spec.Egress) > 0, nil
+	}
+	for _, candidate := range policy.Spec.PolicyTypes {
+		if candidate == policyType {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// peerMatches checks whether a network policy peer selects a pod.
+func peerMatches(peer networkingv1.NetworkPolicyPeer, policyNamespace string, target networkPeer) (bool, error) {
+	if peer.IPBlock != nil {
+		_, cidr, err := net.ParseCIDR(peer.IPBlock.CIDR)
+		if err != nil {
+			return false, err
+		}
+		ip := net.ParseIP(target.pod.Status.PodIP)
+		if ip == nil || !cidr.Contains(ip) {
+			return false, nil
+		}
+		for _, except := range peer.IPBlock.Except {
+			if _, exceptCidr, err := net.ParseCIDR(except); err == nil && exceptCidr.Contains(ip) {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+
+	if peer.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(peer.NamespaceSelector)
+		if err != nil {
+			return false, err
+		}
+		if !selector.Matches(labels.Set(target.namespace.Labels)) {
+			return false, nil
+		}
+	} else if target.pod.Namespace != policyNamespace {
+		// Pod selectors without a namespace selector only select pods in the policy's namespace.
+		return false, nil
+	}
+
+	if peer.PodSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(peer.PodSelector)
+		if err != nil {
+			return false, err
+		}
+		return selector.Matches(labels.Set(target.pod.Labels)), nil
+	}
+	return true, nil
+}
+
+// portMatches checks whether a network policy port allows a port on the destination pod.
+// Named ports are resolved against the destination pod's container ports.
+func portMatches(policyPort networkingv1.NetworkPolicyPort, destination *corev1.Pod, port int32, protocol corev1.Protocol) bool {
+	policyProtocol := corev1.ProtocolTCP
+	if policyPort.Protocol != nil {
+		policyProtocol = *policyPort.Protocol
+	}
+	if policyProtocol != protocol {
+		return false
+	}
+	if policyPort.Port == nil {
+		return true
+	}
+	if policyPort.Port.Type == intstr.String {
+		resolved, ok := containerPortFor(destination, *policyPort.Port, protocol)
+		return ok && resolved == port
+	}
+	if policyPort.EndPort != nil {
+		return port >= policyPort.Port.IntVal && port <= *policyPort.EndPort
+	}
+	return policyPort.Port.IntVal == port
+}
+
+// rulePortsMatch checks whether any of a rule's ports allow the port, an empty list allows all ports.
+func rulePortsMatch(ports []networkingv1.NetworkPolicyPort, destination *corev1.Pod, port int32, protocol corev1.Protocol) bool {
+	if len(ports) == 0 {
+		return true
+	}
+	for _, policyPort := range ports {
+		if portMatches(policyPort, destination, port, protocol) {
+			return true
+		}
+	}
+	return false
+}
+
+// rulePeersMatch checks whether any of a rule's peers select the pod, an empty list selects all pods.
+func rulePeersMatch(peers []networkingv1.NetworkPolicyPeer, policyNamespace string, target networkPeer) (bool, error) {
+	if len(peers) == 0 {
+		return true, nil
+	}
+	for _, peer := range peers {
+		matches, err := peerMatches(peer, policyNamespace, target)
+		if err != nil || matches {
+			return matches, err
+		}
+	}
+	return false, nil
+}
+
+// evaluateNetworkPolicies evaluates the policies in a namespace for traffic to or from a pod.
+// Traffic is allowed if no policy isolates the pod, or if any isolating policy allows it.
+func evaluateNetworkPolicies(policies []networkingv1.NetworkPolicy, policyType networkingv1.PolicyType, subject *corev1.Pod, peer networkPeer, destination *corev1.Pod, port int32, protocol corev1.Protocol) (NetworkPolicyDirectionResult, error) {
+	result := NetworkPolicyDirectionResult{Policies: []string{}, AllowedBy: []string{}}
+	for i := range policies {
+		policy := &policies[i]
+		applies, err := policyAppliesTo(policy, subject, policyType)
+		if err != nil {
+			return result, fmt.Errorf("network policy %s: %w", policy.Name, err)
+		}
+		if !applies {
+			continue
+		}
+		result.Isolated = true
+		result.Policies = append(result.Policies, policy.Name)
+
+		allowed := false
+		if policyType == networkingv1.PolicyTypeIngress {
+			for _, rule := range policy.Spec.Ingress {
+				peersMatch, err := rulePeersMatch(rule.From, policy.Namespace, peer)
+				if err != nil {
+					return result, fmt.Errorf("network policy %s: %w", policy.Name, err)
+				}
+				if peersMatch && rulePortsMatch(rule.Ports, destination, port, protocol) {
+					allowed = true
+					break
+				}
+			}
+		} else {
+			for _, rule := range policy.Spec.Egress {
+				peersMatch, err := rulePeersMatch(rule.To, policy.Namespace, peer)
+				if err != nil {
+					return result, fmt.Errorf("network policy %s: %w", policy.Name, err)
+				}
+				if peersMatch && rulePortsMatch(rule.Ports, destination, port, protocol) {
+					allowed = true
+					break
+				}
+			}
+		}
+		if allowed {
+			result.AllowedBy = append(result.AllowedBy, policy.Name)
+		}
+	}
+	result.Allowed = !result.Isolated || len(result.AllowedBy) > 0
+	return result, nil
+}
+
+func CheckNetworkPolicyHandler(ctx context.Context, req *mcp.CallToolRequest, params CheckNetworkPolicyToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	protocol := corev1.ProtocolTCP
+	if params.Protocol != nil && *params.Protocol != "" {
+		protocol = corev1.Protocol(strings.ToUpper(*params.Protocol))
+	}
+
+	source, err := getNetworkPeer(ctx, params.SourceNamespace, params.SourcePod)
+	if err != nil {
+		slog.Error("Failed to get source pod from Kubernetes API", "tool", req.Params.Name, "pod", params.SourcePod, "namespace", params.SourceNamespace, "error", err)
+		return nil, nil, err
+	}
+	destination, err := getNetworkPeer(ctx, params.DestinationNamespace, params.DestinationPod)
+	if err != nil {
+		slog.Error("Failed to get destination pod from Kubernetes API", "tool", req.Params.Name, "pod", params.DestinationPod, "namespace", params.DestinationNamespace, "error", err)
+		return nil, nil, err
+	}
+
+	sourcePolicies, err := kubernetesApiClient.NetworkingV1().NetworkPolicies(params.SourceNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		slog.Error("Failed to list network policies from Kubernetes API", "tool", req.Params.Name, "namespace", params.SourceNamespace, "error", err)
+		return nil, nil, err
+	}
+	destinationPolicies, err := kubernetesApiClient.NetworkingV1().NetworkPolicies(params.DestinationNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		slog.Error("Failed to list network policies from Kubernetes API", "tool", req.Params.Name, "namespace", params.DestinationNamespace, "error", err)
+		return nil, nil, err
+	}
+
+	result := CheckNetworkPolicyResult{
+		Notes: []string{
+			"Network policies are only enforced if the cluster's network plugin supports them",
+			"Cluster scoped policies such as AdminNetworkPolicy and plugin specific policies are not evaluated",
+		},
+	}
+	result.Egress, err = evaluateNetworkPolicies(sourcePolicies.Items, networkingv1.PolicyTypeEgress, source.pod, *destination, destination.pod, params.Port, protocol)
+	if err != nil {
+		return nil, nil, err
+	}
+	result.Ingress, err = evaluateNetworkPolicies(destinationPolicies.Items, networkingv1.PolicyTypeIngress, destination.pod, *source, destination.pod, params.Port, protocol)
+	if err != nil {
+		return nil, nil, err
+	}
+	result.Allowed = result.Egress.Allowed && result.Ingress.Allowed
+
+	if source.pod.Spec.HostNetwork || destination.pod.Spec.HostNetwork {
+		result.Notes = append(result.Notes, "A pod uses the host network, network policies generally do not apply to host network traffic")
+	}
+	if _, ok := containerPortFor(destination.pod, intstr.FromInt32(params.Port), protocol); !ok {
+		result.Notes = append(result.Notes, fmt.Sprintf("The destination pod does not declare container port %d/%s, check it is listening", params.Port, protocol))
+	}
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal network policy check", "source", params.SourcePod, "destination", params.DestinationPod, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,366 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// maxOverviewItems bounds the number of objects listed per group in the cluster overview.
+const maxOverviewItems = 20
+
+var ClusterOverviewTool = &mcp.Tool{
+	Name:        "cluster_overview",
+	Description: "Get a compact health overview of the Kubernetes cluster: server version, node readiness and pressure, unhealthy pods grouped by reason, deployments with unavailable replicas, pending persistent volume claims and recent Warning events",
+}
+
+type ClusterOverviewToolParams struct {
+	WarningEventMinutes *int `json:"warningEventMinutes,omitempty" jsonschema:"Include Warning events seen in the last N minutes, defaults to 60"`
+}
+
+type NodesOverview struct {
+	Total         int                  `json:"total"`
+	Ready         int                  `json:"ready"`
+	NotReady      []string             `json:"notReady"`
+	Unschedulable []string             `json:"unschedulable"`
+	UnderPressure []NodePressureDetail `json:"underPressure"`
+}
+
+type NodePressureDetail struct {
+	Name       string   `json:"name"`
+	Conditions []string `json:"conditions"`
+}
+
+type UnhealthyPodGroup struct {
+	Reason string   `json:"reason"`
+	Count  int      `json:"count"`
+	Pods   []string `json:"pods"`
+}
+
+type PodsOverview struct {
+	Total     int                 `json:"total"`
+	Unhealthy []UnhealthyPodGroup `json:"unhealthy"`
+}
+
+type UnavailableDeployment struct {
+	Namespace   string `json:"namespace"`
+	Name        string `json:"name"`
+	Desired     int32  `json:"desired"`
+	Available   int32  `json:"available"`
+	Unavailable int32  `json:"unavailable"`
+}
+
+type PendingPersistentVolumeClaim struct {
+	Namespace    string `json:"namespace"`
+	Name         string `json:"name"`
+	StorageClass string `json:"storageClass,omitempty"`
+	Age          string `json:"age"`
+}
+
+type WarningEventSummary struct {
+	Namespace string `json:"namespace,omitempty"`
+	Object    string `json:"object"`
+	EventSummary
+}
+
+type ClusterOverviewResult struct {
+	ServerVersion          string                         `json:"serverVersion"`
+	Nodes                  NodesOverview                  `json:"nodes"`
+	Pods                   PodsOverview                   `json:"pods"`
+	UnavailableDeployments []UnavailableDeployment        `json:"unavailableDeployments"`
+	PendingVolumeClaims    []PendingPersistentVolumeClaim `json:"pendingVolumeClaims"`
+	WarningEvents          []WarningEventSummary          `json:"warningEvents"`
+	Errors                 []string                       `json:"errors,omitempty"`
+}
+
+// unhealthyPodReason returns why a pod is unhealthy, or an empty string if it
+// is healthy. Pods which are not Running or Succeeded are unhealthy, as are
+// running pods with waiting containers, e.g. CrashLoopBackOff.
+func unhealthyPodReason(pod *corev1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Waiting != nil && status.State.Waiting.Reason != "" {
+			return status.State.Waiting.Reason
+		}
+	}
+
+	switch pod.Status.Phase {
+	case corev1.PodRunning, corev1.PodSucceeded:
+		return ""
+	}
+	if pod.Status.Reason != "" {
+		return pod.Status.Reason
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated != nil && status.State.Terminated.Reason != "" {
+			return status.State.Terminated.Reason
+		}
+	}
+	if pod.Status.Phase == "" {
+		return "Unknown"
+	}
+	return string(pod.Status.Phase)
+}
+
+func overviewNodes(ctx context.Context) (NodesOverview, error) {
+	nodes, err := kubernetesApiClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return NodesOverview{}, err
+	}
+
+	overview := NodesOverview{
+		Total:         len(nodes.Items),
+		NotReady:      []string{},
+		Unschedulable: []string{},
+		UnderPressure: []NodePressureDetail{},
+	}
+	for _, node := range nodes.Items {
+		if node.Spec.Unschedulable {
+			overview.Unschedulable = append(overview.Unschedulable, node.Name)
+		}
+		ready := false
+		pressure := []string{}
+		for _, condition := range node.Status.Conditions {
+			switch condition.Type {
+			case corev1.NodeReady:
+				ready = condition.Status == corev1.ConditionTrue
+			case corev1.NodeMemoryPressure, corev1.NodeDiskPressure, corev1.NodePIDPressure, corev1.NodeNetworkUnavailable:
+				if condition.Status == corev1.ConditionTrue {
+					pressure = append(pressure, string(condition.Type))
+				}
+			}
+		}
+		if ready {
+			overview.Ready++
+		} else {
+			overview.NotReady = append(overview.NotReady, node.Name)
+		}
+		if len(pressure) > 0 {
+			overview.UnderPressure = append(overview.UnderPressure, NodePressureDetail{Name: node.Name, Conditions: pressure})
+		}
+	}
+	return overview, nil
+}
+
+func overviewPods(ctx context.Context) (PodsOverview, error) {
+	pods, err := kubernetesApiClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return PodsOverview{}, err
+	}
+
+	groups := map[string]*UnhealthyPodGroup{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		reason := unhealthyPodReason(pod)
+		if reason == "" {
+			continue
+		}
+		group, ok := groups[reason]
+		if !ok {
+			group = &UnhealthyPodGroup{Reason: reason, Pods: []string{}}
+			groups[reason] = group
+		}
+		group.Count++
+		if len(group.Pods) < maxOverviewItems {
+			group.Pods = append(group.Pods, fmt.Sprintf("%s/%s", pod.Namespace, pod.Name))
+		}
+	}
+
+	overview := PodsOverview{Total: len(pods.Items), Unhealthy: []UnhealthyPodGroup{}}
+	for _, group := range groups {
+		overview.Unhealthy = append(overview.Unhealthy, *group)
+	}
+	slices.SortFunc(overview.Unhealthy, func(a, b UnhealthyPodGroup) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Reason, b.Reason))
+	})
+	return overview, nil
+}
+
+func overviewDeployments(ctx context.Context) ([]UnavailableDeployment, error) {
+	deployments, err := kubernetesApiClient.AppsV1().Deployments("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	unavailable := []UnavailableDeployment{}
+	for _, deployment := range deployments.Items {
+		if deployment.Status.UnavailableReplicas == 0 {
+			continue
+		}
+		desired := int32(1)
+		if deployment.Spec.Replicas != nil {
+			desired = *deployment.Spec.Replicas
+		}
+		unavailable = append(unavailable, UnavailableDeployment{
+			Namespace:   deployment.Namespace,
+			Name:        deployment.Name,
+			Desired:     desired,
+			Available:   deployment.Status.AvailableReplicas,
+			Unavailable: deployment.Status.UnavailableReplicas,
+		})
+	}
+	return unavailable, nil
+}
+
+func overviewPendingVolumeClaims(ctx context.Context) ([]PendingPersistentVolumeClaim, error) {
+	claims, err := kubernetesApiClient.CoreV1().PersistentVolumeClaims("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	pending := []PendingPersistentVolumeClaim{}
+	for _, claim := range claims.Items {
+		if claim.Status.Phase != corev1.ClaimPending {
+			continue
+		}
+		summary := PendingPersistentVolumeClaim{
+			Namespace: claim.Namespace,
+			Name:      claim.Name,
+			Age:       formatAge(claim.CreationTimestamp),
+		}
+		if claim.Spec.StorageClassName != nil {
+			summary.StorageClass = *claim.Spec.StorageClassName
+		}
+		pending = append(pending, summary)
+	}
+	return pending, nil
+}
+
+func overviewWarningEvents(ctx context.Context, since time.Duration) ([]WarningEventSummary, error) {
+	events, err := kubernetesApiClient.EventsV1().Events("").List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("type", corev1.EventTypeWarning).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := time.Now().Add(-since)
+	recent := []*eventsv1.Event{}
+	for i := range events.Items {
+		if eventLastSeen(&events.Items[i]).After(cutoff) {
+			recent = append(recent, &events.Items[i])
+		}
+	}
+	slices.SortFunc(recent, func(a, b *eventsv1.Event) int {
+		return eventLastSeen(b).Compare(eventLastSeen(a))
+	})
+	if len(recent) > maxOverviewItems {
+		recent = recent[:maxOverviewItems]
+	}
+
+	summaries := []WarningEventSummary{}
+	for _, event := range recent {
+		summaries = append(summaries, WarningEventSummary{
+			Namespace:    event.Namespace,
+			Object:       fmt.Sprintf("%s/%s", event.Regarding.Kind, event.Regarding.Name),
+			EventSummary: summariseEvent(event),
+		})
+	}
+	return summaries, nil
+}
+
+func ClusterOverviewHandler(ctx context.Context, req *mcp.CallToolRequest, params ClusterOverviewToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	warningEventMinutes := 60
+	if params.WarningEventMinutes != nil && *params.WarningEventMinutes > 0 {
+		warningEventMinutes = *params.WarningEventMinutes
+	}
+
+	// Each section is listed concurrently and is best effort, failures are
+	// reported alongside the sections which succeeded. Progress is reported
+	// as each section completes.
+	const sections = 6
+	// Sections are initialised empty so that failed sections are not null.
+	result := ClusterOverviewResult{
+		Nodes:                  NodesOverview{NotReady: []string{}, Unschedulable: []string{}, UnderPressure: []NodePressureDetail{}},
+		Pods:                   PodsOverview{Unhealthy: []UnhealthyPodGroup{}},
+		UnavailableDeployments: []UnavailableDeployment{},
+		PendingVolumeClaims:    []PendingPersistentVolumeClaim{},
+		WarningEvents:          []WarningEventSummary{},
+		Errors:                 []string{},
+	}
+	var (
+		wg        sync.WaitGroup
+		mutex     sync.Mutex
+		completed int
+		report    = func(section string, err error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			completed++
+			notifyProgress(ctx, req, float64(completed), sections, fmt.Sprintf("Listed %s", section))
+			if err == nil {
+				return
+			}
+			slog.Error("Failed to list cluster overview section", "tool", req.Params.Name, "section", section, "error", err)
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", section, err))
+		}
+	)
+	wg.Go(func() {
+		version, err := getServerVersion(ctx)
+		if err == nil {
+			result.ServerVersion = version.GitVersion
+		}
+		report("serverVersion", err)
+	})
+	wg.Go(func() {
+		section, err := overviewNodes(ctx)
+		if err == nil {
+			result.Nodes = section
+		}
+		report("nodes", err)
+	})
+	wg.Go(func() {
+		section, err := overviewPods(ctx)
+		if err == nil {
+			result.Pods = section
+		}
+		report("pods", err)
+	})
+	wg.Go(func() {
+		section, err := overviewDeployments(ctx)
+		if err == nil {
+			result.UnavailableDeployments = section
+		}
+		report("deployments", err)
+	})
+	wg.Go(func() {
+		section, err := overviewPendingVolumeClaims(ctx)
+		if err == nil {
+			result.PendingVolumeClaims = section
+		}
+		report("persistentVolumeClaims", err)
+	})
+	wg.Go(func() {
+		section, err := overviewWarningEvents(ctx, time.Duration(warningEventMinutes)*time.Minute)
+		if err == nil {
+			result.WarningEvents = section
+		}
+		report("events", err)
+	})
+	wg.Wait()
+	slices.Sort(result.Errors)
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal cluster overview", "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,180 @@
+package tools
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+)
+
+// maxCompletionValues is the maximum number of values in a completion result, per the MCP specification.
+const maxCompletionValues = 100
+
+// completionCacheTTL is how long completion candidates are cached, to avoid
+// listing from the API server on every keystroke.
+const completionCacheTTL = 30 * time.Second
+
+type completionCacheEntry struct {
+	values  []string
+	expires time.Time
+}
+
+var (
+	completionCacheMutex sync.Mutex
+	completionCache      = map[string]completionCacheEntry{}
+)
+
+// cachedCompletionValues returns the cached candidates for a key, listing them if the cache has expired.
+func cachedCompletionValues(key string, list func() ([]string, error)) ([]string, error) {
+	completionCacheMutex.Lock()
+	entry, ok := completionCache[key]
+	completionCacheMutex.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.values, nil
+	}
+
+	values, err := list()
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(values)
+	values = slices.Compact(values)
+
+	// Expired entries are pruned on insert so that the cache does not grow
+	// with every kind and namespace completed over the life of the server.
+	now := time.Now()
+	completionCacheMutex.Lock()
+	maps.DeleteFunc(completionCache, func(_ string, entry completionCacheEntry) bool {
+		return !now.Before(entry.expires)
+	})
+	completionCache[key] = completionCacheEntry{values: values, expires: now.Add(completionCacheTTL)}
+	completionCacheMutex.Unlock()
+	return values, nil
+}
+
+func completeNamespaces(ctx context.Context) ([]string, error) {
+	return cachedCompletionValues("namespaces", func() ([]string, error) {
+		namespaces, err := kubernetesApiClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		values := []string{}
+		for _, namespace := range namespaces.Items {
+			values = append(values, namespace.Name)
+		}
+		return values, nil
+	})
+}
+
+func completeKinds() ([]string, error) {
+	return cachedCompletionValues("kinds", func() ([]string, error) {
+		resourceLists, err := kubernetesCachedDiscoveryClient.ServerPreferredResources()
+		// Partial discovery failures (e.g. an unavailable aggregated API) still return the other groups.
+		if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+			return nil, err
+		}
+		values := []string{}
+		for _, resourceList := range resourceLists {
+			for _, resource := range resourceList.APIResources {
+				// Skip subresources, e.g. pods/log.
+				if strings.Contains(resource.Name, "/") {
+					continue
+				}
+				values = append(values, resource.Kind)
+			}
+		}
+		return values, nil
+	})
+}
+
+// completeNames returns the names of objects of a kind, in the namespace if given
+// or across all namespaces.
+func completeNames(ctx context.Context, kind string, namespace string) ([]string, error) {
+	mapping, err := resolveKindMapping("", kind)
+	if err != nil {
+		return nil, err
+	}
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		namespace = ""
+	}
+
+	return cachedCompletionValues("names/"+mapping.Resource.String()+"/"+namespace, func() ([]string, error) {
+		list, err := resourceInterfaceFor(mapping, namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		values := []string{}
+		for _, item := range list.Items {
+			values = append(values, item.GetName())
+		}
+		return values, nil
+	})
+}
+
+// NewCompletionHandler returns a handler for completion/complete requests,
+// completing namespace, kind and name arguments of prompts and kube:// resource
+// templates from the cluster. The kind for a name argument is taken from a
+// kind argument if present, otherwise from nameKinds, keyed by prompt name or
+// resource template URI.
+func NewCompletionHandler(nameKinds map[string]string) func(context.Context, *mcp.CompleteRequest) (*mcp.CompleteResult, error) {
+	return func(ctx context.Context, req *mcp.CompleteRequest) (*mcp.CompleteResult, error) {
+		slog.Debug("Completion requested", "ref", req.Params.Ref.Type, "argument", req.Params.Argument.Name)
+
+		arguments := map[string]string{}
+		if req.Params.Context != nil && req.Params.Context.Arguments != nil {
+			arguments = req.Params.Context.Arguments
+		}
+
+		var (
+			candidates []string
+			err        error
+		)
+		switch req.Params.Argument.Name {
+		case "namespace":
+			candidates, err = completeNamespaces(ctx)
+		case "kind":
+			candidates, err = completeKinds()
+		case "name":
+			kind := arguments["kind"]
+			if kind == "" {
+				kind = nameKinds[req.Params.Ref.Name+req.Params.Ref.URI]
+			}
+			if kind != "" {
+				candidates, err = completeNames(ctx, kind, arguments["namespace"])
+			}
+		}
+		if err != nil {
+			// Completion is best effort, failures return no suggestions rather than an error.
+			slog.Error("Failed to list completion values", "argument", req.Params.Argument.Name, "error", err)
+		}
+
+		prefix := strings.ToLower(req.Params.Argument.Value)
+		values := []string{}
+		for _, candidate := range candidates {
+			if strings.HasPrefix(strings.ToLower(candidate), prefix) {
+				values = append(values, candidate)
+			}
+		}
+
+		result := &mcp.CompleteResult{
+			Completion: mcp.CompletionResultDetails{
+				Values: values,
+				Total:  len(values),
+			},
+		}
+		if len(values) > maxCompletionValues {
+			result.Completion.Values = values[:maxCompletionValues]
+			result.Completion.HasMore = true
+		}
+		return result, nil
+	}
+}
@@ -0,0 +1,60 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/cturner8/kube-mcp/config"
+)
+
+// RequiresConfirmation checks whether a write tool must be confirmed by the user before executing.
+// All write tools require confirmation unless a list of tools (or "none") has been configured.
+func RequiresConfirmation(toolName string) bool {
+	confirmTools := config.ServerConfig.ConfirmTools
+
+	if len(confirmTools) == 0 {
+		return true
+	}
+	if slices.Contains(confirmTools, "none") {
+		return false
+	}
+	return slices.Contains(confirmTools, toolName)
+}
+
+// confirmAction asks the user to accept or decline the described action using MCP elicitation.
+// An error is returned if the action is declined, or if the client cannot be asked.
+func confirmAction(ctx context.Context, req *mcp.CallToolRequest, action string) error {
+	if !RequiresConfirmation(req.Params.Name) {
+		return nil
+	}
+
+	initializeParams := req.Session.InitializeParams()
+	if initializeParams == nil || initializeParams.Capabilities == nil || initializeParams.Capabilities.Elicitation == nil {
+		slog.Warn("Refusing action, client does not support elicitation", "tool", req.Params.Name, "action", action)
+		return fmt.Errorf("refusing to %s: user confirmation is required but the client does not support elicitation", action)
+	}
+
+	result, err := req.Session.Elicit(ctx, &mcp.ElicitParams{
+		Message: fmt.Sprintf("kube-mcp wants to %s. Do you want to continue?", action),
+		RequestedSchema: map[string]any{
+			"type":       "object",
+			"properties": map[string]any{},
+		},
+	})
+	if err != nil {
+		slog.Error("Failed to elicit user confirmation", "tool", req.Params.Name, "action", action, "error", err)
+		return fmt.Errorf("refusing to %s: unable to get user confirmation: %w", action, err)
+	}
+
+	if result.Action != "accept" {
+		slog.Info("Action not confirmed by user", "tool", req.Params.Name, "action", action, "response", result.Action)
+		return fmt.Errorf("user did not confirm the request to %s (%s)", action, result.Action)
+	}
+
+	slog.Debug("Action confirmed by user", "tool", req.Params.Name, "action", action)
+	return nil
+}
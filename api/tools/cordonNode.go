@@ -0,0 +1,82 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var CordonNodeTool = &mcp.Tool{
+	Name:        "cordon_node",
+	Description: "Mark a node in the Kubernetes cluster as unschedulable",
+}
+
+type CordonNodeToolParams struct {
+	Name string `json:"name" jsonschema:"The name of the node"`
+}
+
+type CordonNodeResult struct {
+	Name                  string `json:"name"`
+	Unschedulable         bool   `json:"unschedulable"`
+	PreviousUnschedulable bool   `json:"previousUnschedulable"`
+}
+
+// setNodeUnschedulable patches the schedulable state of a node, returning the previous state.
+func setNodeUnschedulable(ctx context.Context, name string, unschedulable bool) (bool, error) {
+	node, err := kubernetesApiClient.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	if node.Spec.Unschedulable == unschedulable {
+		return node.Spec.Unschedulable, nil
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"spec": map[string]any{
+			"unschedulable": unschedulable,
+		},
+	})
+	if err != nil {
+		return node.Spec.Unschedulable, err
+	}
+
+	_, err = kubernetesApiClient.CoreV1().Nodes().Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	return node.Spec.Unschedulable, err
+}
+
+func CordonNodeHandler(ctx context.Context, req *mcp.CallToolRequest, params CordonNodeToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	if err := confirmAction(ctx, req, fmt.Sprintf("cordon node %s", params.Name)); err != nil {
+		return nil, nil, err
+	}
+
+	previous, err := setNodeUnschedulable(ctx, params.Name, true)
+	if err != nil {
+		slog.Error("Failed to cordon node", "tool", req.Params.Name, "node", params.Name, "error", err)
+		return nil, nil, err
+	}
+
+	slog.Info("Node cordoned", "node", params.Name)
+
+	resultJson, err := json.Marshal(CordonNodeResult{
+		Name:                  params.Name,
+		Unschedulable:         true,
+		PreviousUnschedulable: previous,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
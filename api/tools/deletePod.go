@@ -0,0 +1,87 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var DeletePodTool = &mcp.Tool{
+	Name:        "delete_pod",
+	Description: "Delete a pod in the Kubernetes cluster. Pods managed by a controller will be recreated",
+}
+
+type DeletePodToolParams struct {
+	Name               string `json:"name" jsonschema:"The name of the pod"`
+	Namespace          string `json:"namespace" jsonschema:"The namespace of the pod"`
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty" jsonschema:"The grace period in seconds before the pod is terminated, defaults to the pod's terminationGracePeriodSeconds"`
+}
+
+type DeletePodResult struct {
+	Name               string `json:"name"`
+	Namespace          string `json:"namespace"`
+	Node               string `json:"node,omitempty"`
+	Deleted            bool   `json:"deleted"`
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+	ControlledBy       string `json:"controlledBy,omitempty"`
+}
+
+func DeletePodHandler(ctx context.Context, req *mcp.CallToolRequest, params DeletePodToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	if params.GracePeriodSeconds != nil && *params.GracePeriodSeconds < 0 {
+		return nil, nil, fmt.Errorf("gracePeriodSeconds must not be negative, got %d", *params.GracePeriodSeconds)
+	}
+
+	pod, err := kubernetesApiClient.CoreV1().Pods(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get pod from Kubernetes API", "tool", req.Params.Name, "pod", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	action := fmt.Sprintf("delete pod %s in namespace %s", params.Name, params.Namespace)
+	if params.GracePeriodSeconds != nil {
+		action = fmt.Sprintf("%s with a grace period of %ds", action, *params.GracePeriodSeconds)
+	}
+	if err := confirmAction(ctx, req, action); err != nil {
+		return nil, nil, err
+	}
+
+	err = kubernetesApiClient.CoreV1().Pods(params.Namespace).Delete(ctx, params.Name, metav1.DeleteOptions{
+		GracePeriodSeconds: params.GracePeriodSeconds,
+		Preconditions:      metav1.NewUIDPreconditions(string(pod.UID)),
+	})
+	if err != nil {
+		slog.Error("Failed to delete pod", "tool", req.Params.Name, "pod", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	slog.Info("Pod deleted", "pod", params.Name, "namespace", params.Namespace)
+
+	result := DeletePodResult{
+		Name:               params.Name,
+		Namespace:          params.Namespace,
+		Node:               pod.Spec.NodeName,
+		Deleted:            true,
+		GracePeriodSeconds: params.GracePeriodSeconds,
+	}
+	if owner := metav1.GetControllerOf(pod); owner != nil {
+		result.ControlledBy = fmt.Sprintf("%s/%s", owner.Kind, owner.Name)
+	}
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
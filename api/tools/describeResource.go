@@ -0,0 +1,203 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var DescribeResourceTool = &mcp.Tool{
+	Name:        "describe_resource",
+	Description: "Describe a resource in the Kubernetes cluster, similar to kubectl describe. Returns the object with its events, owner references resolved up to the top-level controller, and related objects",
+}
+
+type DescribeResourceToolParams struct {
+	Kind       string  `json:"kind" jsonschema:"The kind or resource name, e.g. Pod, deployments.apps or svc"`
+	Name       string  `json:"name" jsonschema:"The name of the resource"`
+	Namespace  *string `json:"namespace,omitempty" jsonschema:"The namespace of the resource, required for namespaced resources"`
+	APIVersion *string `json:"apiVersion,omitempty" jsonschema:"The API version of the kind, e.g. apps/v1, to disambiguate kinds served by multiple groups"`
+}
+
+type DescribeResourceResult struct {
+	Object  map[string]any `json:"object"`
+	Events  []EventSummary `json:"events"`
+	Owners  []OwnerSummary `json:"owners"`
+	Related map[string]any `json:"related,omitempty"`
+	Errors  []string       `json:"errors,omitempty"`
+}
+
+type NodeConditionsSummary struct {
+	Name          string                 `json:"name"`
+	Unschedulable bool                   `json:"unschedulable"`
+	Conditions    []corev1.NodeCondition `json:"conditions"`
+}
+
+type EndpointSliceSummary struct {
+	Name        string                         `json:"name"`
+	AddressType discoveryv1.AddressType        `json:"addressType"`
+	Ports       []discoveryv1.EndpointPort     `json:"ports"`
+	Endpoints   []EndpointSliceEndpointSummary `json:"endpoints"`
+}
+
+type EndpointSliceEndpointSummary struct {
+	Addresses []string `json:"addresses"`
+	Ready     bool     `json:"ready"`
+	NodeName  string   `json:"nodeName,omitempty"`
+	TargetRef string   `json:"targetRef,omitempty"`
+}
+
+func summariseEndpointSlice(slice *discoveryv1.EndpointSlice) EndpointSliceSummary {
+	summary := EndpointSliceSummary{
+		Name:        slice.Name,
+		AddressType: slice.AddressType,
+		Ports:       slice.Ports,
+		Endpoints:   []EndpointSliceEndpointSummary{},
+	}
+	for _, endpoint := range slice.Endpoints {
+		endpointSummary := EndpointSliceEndpointSummary{
+			Addresses: endpoint.Addresses,
+			// A nil ready condition should be interpreted as ready.
+			Ready: endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready,
+		}
+		if endpoint.NodeName != nil {
+			endpointSummary.NodeName = *endpoint.NodeName
+		}
+		if endpoint.TargetRef != nil {
+			endpointSummary.TargetRef = fmt.Sprintf("%s/%s", endpoint.TargetRef.Kind, endpoint.TargetRef.Name)
+		}
+		summary.Endpoints = append(summary.Endpoints, endpointSummary)
+	}
+	return summary
+}
+
+// describeRelated returns objects linked to the described object which are
+// useful when debugging it, keyed by relationship.
+func describeRelated(ctx context.Context, gvk schema.GroupVersionKind, object *unstructured.Unstructured) (map[string]any, error) {
+	related := map[string]any{}
+	if gvk.Group != "" {
+		return related, nil
+	}
+
+	switch gvk.Kind {
+	case "Pod":
+		nodeName, _, _ := unstructured.NestedString(object.Object, "spec", "nodeName")
+		if nodeName == "" {
+			return related, nil
+		}
+		node, err := kubernetesApiClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return related, err
+		}
+		related["node"] = NodeConditionsSummary{
+			Name:          node.Name,
+			Unschedulable: node.Spec.Unschedulable,
+			Conditions:    node.Status.Conditions,
+		}
+	case "Service":
+		endpointSlices, err := kubernetesApiClient.DiscoveryV1().EndpointSlices(object.GetNamespace()).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", discoveryv1.LabelServiceName, object.GetName()),
+		})
+		if err != nil {
+			return related, err
+		}
+		summaries := []EndpointSliceSummary{}
+		for i := range endpointSlices.Items {
+			summaries = append(summaries, summariseEndpointSlice(&endpointSlices.Items[i]))
+		}
+		related["endpointSlices"] = summaries
+	case "PersistentVolumeClaim":
+		volumeName, _, _ := unstructured.NestedString(object.Object, "spec", "volumeName")
+		if volumeName == "" {
+			return related, nil
+		}
+		volume, err := kubernetesApiClient.CoreV1().PersistentVolumes().Get(ctx, volumeName, metav1.GetOptions{})
+		if err != nil {
+			return related, err
+		}
+		volume.ManagedFields = nil
+		related["persistentVolume"] = volume
+	}
+	return related, nil
+}
+
+// describeResource gets a resource with its events, owners and related objects.
+// Lookups other than the resource itself are best effort, failures are reported alongside it.
+func describeResource(ctx context.Context, apiVersion string, kind string, name string, namespace string) (*DescribeResourceResult, error) {
+	mapping, err := resolveKindMapping(apiVersion, kind)
+	if err != nil {
+		return nil, err
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace && namespace == "" {
+		return nil, fmt.Errorf("namespace is required for namespaced kind %s", mapping.GroupVersionKind.Kind)
+	}
+	if err := checkKindAllowed(mapping); err != nil {
+		return nil, err
+	}
+
+	object, err := resourceInterfaceFor(mapping, namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DescribeResourceResult{Errors: []string{}}
+
+	result.Events, err = listObjectEvents(ctx, object.GetNamespace(), object.GetUID())
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("events: %v", err))
+	}
+
+	result.Owners, err = resolveOwnerChain(ctx, object)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("owners: %v", err))
+	}
+
+	result.Related, err = describeRelated(ctx, mapping.GroupVersionKind, object)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("related: %v", err))
+	}
+
+	unstructured.RemoveNestedField(object.Object, "metadata", "managedFields")
+	result.Object = object.Object
+	return result, nil
+}
+
+func DescribeResourceHandler(ctx context.Context, req *mcp.CallToolRequest, params DescribeResourceToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+	apiVersion := ""
+	if params.APIVersion != nil {
+		apiVersion = *params.APIVersion
+	}
+
+	result, err := describeResource(ctx, apiVersion, params.Kind, params.Name, namespace)
+	if err != nil {
+		slog.Error("Failed to describe resource", "tool", req.Params.Name, "kind", params.Kind, "name", params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal resource description", "kind", params.Kind, "name", params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
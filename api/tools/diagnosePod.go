@@ -0,0 +1,465 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var DiagnosePodTool = &mcp.Tool{
+	Name:        "diagnose_pod",
+	Description: "Diagnose why a pod in the Kubernetes cluster is not running or ready. Checks container statuses, events, scheduling, node conditions, volumes and configuration references, returning findings with severity and evidence",
+}
+
+type DiagnosePodToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the pod"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the pod"`
+}
+
+type DiagnosePodResult struct {
+	Name      string    `json:"name"`
+	Namespace string    `json:"namespace"`
+	Phase     string    `json:"phase"`
+	Ready     bool      `json:"ready"`
+	Node      string    `json:"node,omitempty"`
+	Healthy   bool      `json:"healthy"`
+	Findings  []Finding `json:"findings"`
+	Errors    []string  `json:"errors,omitempty"`
+}
+
+// podDiagnosis holds the objects collected for a pod which rules are evaluated against.
+type podDiagnosis struct {
+	pod    *corev1.Pod
+	events []EventSummary
+	node   *corev1.Node
+	errors []string
+}
+
+// podRule inspects a pod diagnosis and returns any findings.
+type podRule func(ctx context.Context, diagnosis *podDiagnosis) []Finding
+
+var podRules = []podRule{
+	podEvictedRule,
+	podSchedulingRule,
+	podContainerStateRule,
+	podReadinessRule,
+	podNodeConditionsRule,
+	podVolumeClaimsRule,
+	podConfigReferencesRule,
+}
+
+// eventEvidence returns evidence lines for events with any of the given reasons.
+func eventEvidence(events []EventSummary, reasons ...string) []string {
+	evidence := []string{}
+	for _, event := range events {
+		if slices.Contains(reasons, event.Reason) {
+			evidence = append(evidence, fmt.Sprintf("event %s (x%d, last %s): %s", event.Reason, event.Count, event.LastSeen, event.Message))
+		}
+	}
+	return evidence
+}
+
+func podEvictedRule(_ context.Context, diagnosis *podDiagnosis) []Finding {
+	pod := diagnosis.pod
+	if pod.Status.Reason != "Evicted" {
+		return nil
+	}
+	return []Finding{{
+		Type:     "Evicted",
+		Severity: severityCritical,
+		Message:  "The pod was evicted from its node",
+		Evidence: []string{fmt.Sprintf("status.message: %s", pod.Status.Message)},
+	}}
+}
+
+func podSchedulingRule(_ context.Context, diagnosis *podDiagnosis) []Finding {
+	for _, condition := range diagnosis.pod.Status.Conditions {
+		if condition.Type != corev1.PodScheduled || condition.Status != corev1.ConditionFalse {
+			continue
+		}
+		evidence := []string{fmt.Sprintf("condition PodScheduled=False reason=%s: %s", condition.Reason, condition.Message)}
+		return []Finding{{
+			Type:     "FailedScheduling",
+			Severity: severityCritical,
+			Message:  "The pod cannot be scheduled onto a node",
+			Evidence: append(evidence, eventEvidence(diagnosis.events, "FailedScheduling")...),
+		}}
+	}
+	return nil
+}
+
+// containerStateFindings evaluates the state of a single (init) container.
+func containerStateFindings(diagnosis *podDiagnosis, status corev1.ContainerStatus, resources corev1.ResourceRequirements) []Finding {
+	findings := []Finding{}
+
+	lastTerminated, terminated := status.LastTerminationState.Terminated, status.State.Terminated
+	if (lastTerminated != nil && lastTerminated.Reason == "OOMKilled") || (terminated != nil && terminated.Reason == "OOMKilled") {
+		evidence := []string{fmt.Sprintf("restartCount: %d", status.RestartCount)}
+		if limit, ok := resources.Limits[corev1.ResourceMemory]; ok {
+			evidence = append(evidence, fmt.Sprintf("memory limit: %s", limit.String()))
+		} else {
+			evidence = append(evidence, "no memory limit set, the node ran out of memory")
+		}
+		findings = append(findings, Finding{
+			Type:      "OOMKilled",
+			Severity:  severityCritical,
+			Container: status.Name,
+			Message:   "The container was killed for exceeding its memory limit",
+			Evidence:  evidence,
+		})
+	}
+
+	if waiting := status.State.Waiting; waiting != nil {
+		switch waiting.Reason {
+		case "CrashLoopBackOff":
+			evidence := []string{fmt.Sprintf("restartCount: %d", status.RestartCount)}
+			if lastTerminated != nil {
+				evidence = append(evidence, fmt.Sprintf("last exit code: %d, reason: %s, finished: %s", lastTerminated.ExitCode, lastTerminated.Reason, formatTime(&lastTerminated.FinishedAt)))
+				if lastTerminated.Message != "" {
+					evidence = append(evidence, fmt.Sprintf("last termination message: %s", lastTerminated.Message))
+				}
+			}
+			findings = append(findings, Finding{
+				Type:      "CrashLoopBackOff",
+				Severity:  severityCritical,
+				Container: status.Name,
+				Message:   "The container is repeatedly crashing after starting",
+				Evidence:  append(evidence, eventEvidence(diagnosis.events, "BackOff")...),
+			})
+		case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "ErrImageNeverPull":
+			evidence := []string{fmt.Sprintf("image: %s", status.Image), fmt.Sprintf("waiting: %s", waiting.Message)}
+			findings = append(findings, Finding{
+				Type:      "ImagePullError",
+				Severity:  severityCritical,
+				Container: status.Name,
+				Message:   "The container image cannot be pulled",
+				Evidence:  append(evidence, eventEvidence(diagnosis.events, "Failed", "ErrImagePull")...),
+			})
+		case "CreateContainerConfigError", "CreateContainerError", "RunContainerError":
+			findings = append(findings, Finding{
+				Type:      waiting.Reason,
+				Severity:  severityCritical,
+				Container: status.Name,
+				Message:   "The container cannot be created from its configuration",
+				Evidence:  append([]string{fmt.Sprintf("waiting: %s", waiting.Message)}, eventEvidence(diagnosis.events, "Failed")...),
+			})
+		}
+	}
+
+	if terminated != nil && terminated.ExitCode != 0 && terminated.Reason != "OOMKilled" {
+		findings = append(findings, Finding{
+			Type:      "ContainerFailed",
+			Severity:  severityWarning,
+			Container: status.Name,
+			Message:   "The container terminated with a non-zero exit code",
+			Evidence:  []string{fmt.Sprintf("exit code: %d, reason: %s, message: %s", terminated.ExitCode, terminated.Reason, terminated.Message)},
+		})
+	}
+
+	return findings
+}
+
+func podContainerStateRule(_ context.Context, diagnosis *podDiagnosis) []Finding {
+	pod := diagnosis.pod
+	findings := []Finding{}
+
+	resources := map[string]corev1.ResourceRequirements{}
+	for _, container := range append(slices.Clone(pod.Spec.InitContainers), pod.Spec.Containers...) {
+		resources[container.Name] = container.Resources
+	}
+
+	for _, status := range append(slices.Clone(pod.Status.InitContainerStatuses), pod.Status.ContainerStatuses...) {
+		findings = append(findings, containerStateFindings(diagnosis, status, resources[status.Name])...)
+	}
+	return findings
+}
+
+func podReadinessRule(_ context.Context, diagnosis *podDiagnosis) []Finding {
+	findings := []Finding{}
+	for _, status := range diagnosis.pod.Status.ContainerStatuses {
+		if status.State.Running == nil || status.Ready {
+			continue
+		}
+		findings = append(findings, Finding{
+			Type:      "NotReady",
+			Severity:  severityWarning,
+			Container: status.Name,
+			Message:   "The container is running but not ready, its readiness or startup probe is failing",
+			Evidence:  append([]string{fmt.Sprintf("started: %s", formatTime(&status.State.Running.StartedAt))}, eventEvidence(diagnosis.events, "Unhealthy")...),
+		})
+	}
+	return findings
+}
+
+func podNodeConditionsRule(_ context.Context, diagnosis *podDiagnosis) []Finding {
+	node := diagnosis.node
+	if node == nil {
+		return nil
+	}
+
+	findings := []Finding{}
+	for _, condition := range node.Status.Conditions {
+		evidence := []string{fmt.Sprintf("node %s condition %s=%s reason=%s: %s", node.Name, condition.Type, condition.Status, condition.Reason, condition.Message)}
+		switch {
+		case condition.Type == corev1.NodeReady && condition.Status != corev1.ConditionTrue:
+			findings = append(findings, Finding{
+				Type:     "NodeNotReady",
+				Severity: severityCritical,
+				Message:  "The node the pod is scheduled on is not ready",
+				Evidence: evidence,
+			})
+		case condition.Type != corev1.NodeReady && condition.Status == corev1.ConditionTrue:
+			findings = append(findings, Finding{
+				Type:     fmt.Sprintf("Node%s", condition.Type),
+				Severity: severityWarning,
+				Message:  fmt.Sprintf("The node the pod is scheduled on reports %s", condition.Type),
+				Evidence: evidence,
+			})
+		}
+	}
+	return findings
+}
+
+func podVolumeClaimsRule(ctx context.Context, diagnosis *podDiagnosis) []Finding {
+	pod := diagnosis.pod
+	findings := []Finding{}
+
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+		claimName := volume.PersistentVolumeClaim.ClaimName
+		claim, err := kubernetesApiClient.CoreV1().PersistentVolumeClaims(pod.Namespace).Get(ctx, claimName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			findings = append(findings, Finding{
+				Type:     "MissingPersistentVolumeClaim",
+				Severity: severityCritical,
+				Message:  fmt.Sprintf("The persistent volume claim %s used by volume %s does not exist", claimName, volume.Name),
+			})
+			continue
+		}
+		if err != nil {
+			diagnosis.errors = append(diagnosis.errors, fmt.Sprintf("persistentvolumeclaim %s: %v", claimName, err))
+			continue
+		}
+		if claim.Status.Phase == corev1.ClaimBound {
+			continue
+		}
+
+		storageClass := ""
+		if claim.Spec.StorageClassName != nil {
+			storageClass = *claim.Spec.StorageClassName
+		}
+		evidence := []string{fmt.Sprintf("phase: %s, storageClass: %q", claim.Status.Phase, storageClass)}
+		events, err := listObjectEvents(ctx, claim.Namespace, claim.UID)
+		if err == nil {
+			for _, event := range events {
+				evidence = append(evidence, fmt.Sprintf("event %s (x%d): %s", event.Reason, event.Count, event.Message))
+			}
+		}
+		findings = append(findings, Finding{
+			Type:     "UnboundPersistentVolumeClaim",
+			Severity: severityCritical,
+			Message:  fmt.Sprintf("The persistent volume claim %s used by volume %s is not bound", claimName, volume.Name),
+			Evidence: evidence,
+		})
+	}
+	return findings
+}
+
+// configReference is a reference from a pod to a ConfigMap or Secret, optionally to a single key.
+type configReference struct {
+	kind      string
+	name      string
+	key       string
+	container string
+	source    string
+}
+
+// podConfigReferences collects the required ConfigMap and Secret references of a pod.
+func podConfigReferences(pod *corev1.Pod) []configReference {
+	references := []configReference{}
+	isRequired := func(optional *bool) bool { return optional == nil || !*optional }
+
+	for _, container := range append(slices.Clone(pod.Spec.InitContainers), pod.Spec.Containers...) {
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if ref := env.ValueFrom.ConfigMapKeyRef; ref != nil && isRequired(ref.Optional) {
+				references = append(references, configReference{"ConfigMap", ref.Name, ref.Key, container.Name, "env " + env.Name})
+			}
+			if ref := env.ValueFrom.SecretKeyRef; ref != nil && isRequired(ref.Optional) {
+				references = append(references, configReference{"Secret", ref.Name, ref.Key, container.Name, "env " + env.Name})
+			}
+		}
+		for _, envFrom := range container.EnvFrom {
+			if ref := envFrom.ConfigMapRef; ref != nil && isRequired(ref.Optional) {
+				references = append(references, configReference{"ConfigMap", ref.Name, "", container.Name, "envFrom"})
+			}
+			if ref := envFrom.SecretRef; ref != nil && isRequired(ref.Optional) {
+				references = append(references, configReference{"Secret", ref.Name, "", container.Name, "envFrom"})
+			}
+		}
+	}
+	for _, volume := range pod.Spec.Volumes {
+		if ref := volume.ConfigMap; ref != nil && isRequired(ref.Optional) {
+			references = append(references, configReference{"ConfigMap", ref.Name, "", "", "volume " + volume.Name})
+		}
+		if ref := volume.Secret; ref != nil && isRequired(ref.Optional) {
+			references = append(references, configReference{"Secret", ref.SecretName, "", "", "volume " + volume.Name})
+		}
+	}
+	return references
+}
+
+// getConfigKeys returns the keys of a ConfigMap or Secret, or nil if it does not exist.
+func getConfigKeys(ctx context.Context, kind string, namespace string, name string) (map[string]bool, error) {
+	keys := map[string]bool{}
+	switch kind {
+	case "ConfigMap":
+		configMap, err := kubernetesApiClient.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		for key := range configMap.Data {
+			keys[key] = true
+		}
+		for key := range configMap.BinaryData {
+			keys[key] = true
+		}
+	case "Secret":
+		secret, err := kubernetesApiClient.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		for key := range secret.Data {
+			keys[key] = true
+		}
+	}
+	return keys, nil
+}
+
+func podConfigReferencesRule(ctx context.Context, diagnosis *podDiagnosis) []Finding {
+	pod := diagnosis.pod
+	findings := []Finding{}
+
+	// Each object is fetched once, objects which cannot be read (e.g. forbidden secrets) are skipped.
+	objectKeys := map[string]map[string]bool{}
+	unreadable := map[string]bool{}
+	for _, reference := range podConfigReferences(pod) {
+		id := reference.kind + "/" + reference.name
+		if unreadable[id] {
+			continue
+		}
+		keys, fetched := objectKeys[id]
+		if !fetched {
+			var err error
+			keys, err = getConfigKeys(ctx, reference.kind, pod.Namespace, reference.name)
+			if err != nil {
+				diagnosis.errors = append(diagnosis.errors, fmt.Sprintf("%s: %v", id, err))
+				unreadable[id] = true
+				continue
+			}
+			objectKeys[id] = keys
+		}
+
+		switch {
+		case keys == nil:
+			findings = append(findings, Finding{
+				Type:      fmt.Sprintf("Missing%s", reference.kind),
+				Severity:  severityCritical,
+				Container: reference.container,
+				Message:   fmt.Sprintf("The %s %s referenced by %s does not exist", reference.kind, reference.name, reference.source),
+			})
+		case reference.key != "" && !keys[reference.key]:
+			findings = append(findings, Finding{
+				Type:      fmt.Sprintf("Missing%sKey", reference.kind),
+				Severity:  severityCritical,
+				Container: reference.container,
+				Message:   fmt.Sprintf("The key %s referenced by %s does not exist in %s %s", reference.key, reference.source, reference.kind, reference.name),
+			})
+		}
+	}
+	return findings
+}
+
+func DiagnosePodHandler(ctx context.Context, req *mcp.CallToolRequest, params DiagnosePodToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	pod, err := kubernetesApiClient.CoreV1().Pods(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get pod from Kubernetes API", "tool", req.Params.Name, "pod", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	diagnosis := &podDiagnosis{pod: pod, errors: []string{}}
+
+	diagnosis.events, err = listObjectEvents(ctx, pod.Namespace, pod.UID)
+	if err != nil {
+		diagnosis.errors = append(diagnosis.errors, fmt.Sprintf("events: %v", err))
+	}
+	if pod.Spec.NodeName != "" {
+		diagnosis.node, err = kubernetesApiClient.CoreV1().Nodes().Get(ctx, pod.Spec.NodeName, metav1.GetOptions{})
+		if err != nil {
+			diagnosis.errors = append(diagnosis.errors, fmt.Sprintf("node %s: %v", pod.Spec.NodeName, err))
+		}
+	}
+
+	findings := []Finding{}
+	for _, rule := range podRules {
+		findings = append(findings, rule(ctx, diagnosis)...)
+	}
+	sortFindings(findings)
+
+	// Surface warning events which no rule has accounted for.
+	if len(findings) == 0 {
+		for _, event := range diagnosis.events {
+			if event.Type == corev1.EventTypeWarning {
+				findings = append(findings, Finding{
+					Type:     event.Reason,
+					Severity: severityInfo,
+					Message:  "Warning event recorded for the pod",
+					Evidence: []string{fmt.Sprintf("event %s (x%d, last %s): %s", event.Reason, event.Count, event.LastSeen, event.Message)},
+				})
+			}
+		}
+	}
+
+	result := DiagnosePodResult{
+		Name:      pod.Name,
+		Namespace: pod.Namespace,
+		Phase:     string(pod.Status.Phase),
+		Ready:     isPodReady(pod),
+		Node:      pod.Spec.NodeName,
+		Healthy:   !hasProblemFindings(findings),
+		Findings:  findings,
+		Errors:    diagnosis.errors,
+	}
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal pod diagnosis", "pod", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
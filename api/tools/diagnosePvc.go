@@ -0,0 +1,375 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+	selectedNodeAnnotation        = "volume.kubernetes.io/selected-node"
+	noProvisioner                 = "kubernetes.io/no-provisioner"
+)
+
+var DiagnosePVCTool = &mcp.Tool{
+	Name:        "diagnose_pvc",
+	Description: "Diagnose why a persistent volume claim in the Kubernetes cluster is not bound. Correlates the claim with its storage class, volume binding mode, matching persistent volumes, provisioner events and the pods using it, returning findings with severity and evidence",
+}
+
+type DiagnosePVCToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the pvc"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the pvc"`
+}
+
+type PVCConsumer struct {
+	Name      string `json:"name"`
+	Phase     string `json:"phase"`
+	Node      string `json:"node,omitempty"`
+	Scheduled bool   `json:"scheduled"`
+}
+
+type DiagnosePVCResult struct {
+	Name              string         `json:"name"`
+	Namespace         string         `json:"namespace"`
+	Phase             string         `json:"phase"`
+	StorageClass      string         `json:"storageClass,omitempty"`
+	Provisioner       string         `json:"provisioner,omitempty"`
+	VolumeBindingMode string         `json:"volumeBindingMode,omitempty"`
+	Volume            string         `json:"volume,omitempty"`
+	Requested         string         `json:"requested,omitempty"`
+	Capacity          string         `json:"capacity,omitempty"`
+	MatchingVolumes   []string       `json:"matchingVolumes"`
+	Pods              []PVCConsumer  `json:"pods"`
+	Events            []EventSummary `json:"events"`
+	Healthy           bool           `json:"healthy"`
+	Findings          []Finding      `json:"findings"`
+	Errors            []string       `json:"errors,omitempty"`
+}
+
+// claimStorageClass resolves the storage class of a claim, falling back to the
+// cluster default when none is set. An empty class name only binds to volumes without a class.
+func claimStorageClass(ctx context.Context, claim *corev1.PersistentVolumeClaim) (*storagev1.StorageClass, error) {
+	if claim.Spec.StorageClassName != nil {
+		if *claim.Spec.StorageClassName == "" {
+			return nil, nil
+		}
+		return kubernetesApiClient.StorageV1().StorageClasses().Get(ctx, *claim.Spec.StorageClassName, metav1.GetOptions{})
+	}
+
+	storageClasses, err := kubernetesApiClient.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range storageClasses.Items {
+		if storageClasses.Items[i].Annotations[defaultStorageClassAnnotation] == "true" {
+			return &storageClasses.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// volumeMismatch explains why a persistent volume cannot bind to a claim, or returns an empty string if it can.
+func volumeMismatch(volume *corev1.PersistentVolume, claim *corev1.PersistentVolumeClaim, className string) string {
+	if volume.Spec.ClaimRef != nil && (volume.Spec.ClaimRef.Namespace != claim.Namespace || volume.Spec.ClaimRef.Name != claim.Name) {
+		return fmt.Sprintf("reserved for claim %s/%s", volume.Spec.ClaimRef.Namespace, volume.Spec.ClaimRef.Name)
+	}
+	if volume.Status.Phase != corev1.VolumeAvailable && volume.Spec.ClaimRef == nil {
+		return fmt.Sprintf("phase is %s", volume.Status.Phase)
+	}
+	if volume.Spec.StorageClassName != className {
+		return fmt.Sprintf("storage class is %q", volume.Spec.StorageClassName)
+	}
+	for _, accessMode := range claim.Spec.AccessModes {
+		if !slices.Contains(volume.Spec.AccessModes, accessMode) {
+			return fmt.Sprintf("does not support access mode %s", accessMode)
+		}
+	}
+	claimVolumeMode, volumeMode := corev1.PersistentVolumeFilesystem, corev1.PersistentVolumeFilesystem
+	if claim.Spec.VolumeMode != nil {
+		claimVolumeMode = *claim.Spec.VolumeMode
+	}
+	if volume.Spec.VolumeMode != nil {
+		volumeMode = *volume.Spec.VolumeMode
+	}
+	if claimVolumeMode != volumeMode {
+		return fmt.Sprintf("volume mode is %s", volumeMode)
+	}
+	requested := claim.Spec.Resources.Requests[corev1.ResourceStorage]
+	capacity := volume.Spec.Capacity[corev1.ResourceStorage]
+	if capacity.Cmp(requested) < 0 {
+		return fmt.Sprintf("capacity %s is less than the requested %s", capacity.String(), requested.String())
+	}
+	return ""
+}
+
+// claimConsumers finds the pods in the claim's namespace which mount it.
+func claimConsumers(ctx context.Context, claim *corev1.PersistentVolumeClaim) ([]corev1.Pod, error) {
+	pods, err := kubernetesApiClient.CoreV1().Pods(claim.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	consumers := []corev1.Pod{}
+	for _, pod := range pods.Items {
+		usesClaim := slices.ContainsFunc(pod.Spec.Volumes, func(volume corev1.Volume) bool {
+			return volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == claim.Name
+		})
+		if usesClaim {
+			consumers = append(consumers, pod)
+		}
+	}
+	return consumers, nil
+}
+
+func podScheduledCondition(pod *corev1.Pod) *corev1.PodCondition {
+	for i := range pod.Status.Conditions {
+		if pod.Status.Conditions[i].Type == corev1.PodScheduled {
+			return &pod.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func DiagnosePVCHandler(ctx context.Context, req *mcp.CallToolRequest, params DiagnosePVCToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	claim, err := kubernetesApiClient.CoreV1().PersistentVolumeClaims(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get persistent volume claim from Kubernetes API", "tool", req.Params.Name, "pvc", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	result := DiagnosePVCResult{
+		Name:            claim.Name,
+		Namespace:       claim.Namespace,
+		Phase:           string(claim.Status.Phase),
+		Volume:          claim.Spec.VolumeName,
+		MatchingVolumes: []string{},
+		Pods:            []PVCConsumer{},
+		Events:          []EventSummary{},
+		Findings:        []Finding{},
+		Errors:          []string{},
+	}
+	requested := claim.Spec.Resources.Requests[corev1.ResourceStorage]
+	if !requested.IsZero() {
+		result.Requested = requested.String()
+	}
+	if capacity, ok := claim.Status.Capacity[corev1.ResourceStorage]; ok {
+		result.Capacity = capacity.String()
+	}
+
+	events, err := listObjectEvents(ctx, claim.Namespace, claim.UID)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("events: %v", err))
+	} else {
+		result.Events = events
+	}
+
+	storageClass, err := claimStorageClass(ctx, claim)
+	switch {
+	case apierrors.IsNotFound(err):
+		result.StorageClass = *claim.Spec.StorageClassName
+		result.Findings = append(result.Findings, Finding{
+			Type:     "MissingStorageClass",
+			Severity: severityCritical,
+			Message:  fmt.Sprintf("The storage class %s does not exist, so no volume can be provisioned for the claim", result.StorageClass),
+		})
+	case err != nil:
+		result.Errors = append(result.Errors, fmt.Sprintf("storageclass: %v", err))
+	case storageClass == nil && claim.Spec.StorageClassName == nil && claim.Spec.VolumeName == "":
+		result.Findings = append(result.Findings, Finding{
+			Type:     "NoDefaultStorageClass",
+			Severity: severityWarning,
+			Message:  "The claim has no storage class and the cluster has no default storage class, it can only bind to a pre-provisioned volume without a class",
+		})
+	case storageClass != nil:
+		result.StorageClass = storageClass.Name
+		result.Provisioner = storageClass.Provisioner
+		if storageClass.VolumeBindingMode != nil {
+			result.VolumeBindingMode = string(*storageClass.VolumeBindingMode)
+		}
+	}
+
+	// A claim waiting on an external provisioner which has not started provisioning
+	// suggests the provisioner is not running. CSIDriver objects are optional, and
+	// non-CSI provisioners never have one, so they are not checked.
+	if storageClass != nil && claim.Status.Phase == corev1.ClaimPending {
+		waiting := eventEvidence(result.Events, "ExternalProvisioning")
+		started := slices.ContainsFunc(result.Events, func(event EventSummary) bool {
+			return event.Reason == "Provisioning" && strings.HasPrefix(event.Source, storageClass.Provisioner)
+		})
+		if len(waiting) > 0 && !started {
+			result.Findings = append(result.Findings, Finding{
+				Type:     "MissingProvisioner",
+				Severity: severityWarning,
+				Message:  fmt.Sprintf("The claim is waiting for the provisioner %s of storage class %s, which has not started provisioning, check the provisioner is installed and running", storageClass.Provisioner, storageClass.Name),
+				Evidence: waiting,
+			})
+		}
+	}
+	if evidence := eventEvidence(result.Events, "ProvisioningFailed"); len(evidence) > 0 {
+		result.Findings = append(result.Findings, Finding{
+			Type:     "ProvisioningFailed",
+			Severity: severityCritical,
+			Message:  "The provisioner failed to create a volume for the claim",
+			Evidence: evidence,
+		})
+	}
+
+	consumers, err := claimConsumers(ctx, claim)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("pods: %v", err))
+	}
+	unschedulable := []string{}
+	for i := range consumers {
+		pod := &consumers[i]
+		consumer := PVCConsumer{Name: pod.Name, Phase: string(pod.Status.Phase), Node: pod.Spec.NodeName, Scheduled: pod.Spec.NodeName != ""}
+		if condition := podScheduledCondition(pod); condition != nil && condition.Status == corev1.ConditionFalse {
+			unschedulable = append(unschedulable, fmt.Sprintf("pod %s: %s %s", pod.Name, condition.Reason, condition.Message))
+		}
+		result.Pods = append(result.Pods, consumer)
+	}
+
+	switch claim.Status.Phase {
+	case corev1.ClaimLost:
+		result.Findings = append(result.Findings, Finding{
+			Type:     "VolumeLost",
+			Severity: severityCritical,
+			Message:  fmt.Sprintf("The claim has lost its persistent volume %s, the volume no longer exists or was bound to another claim", claim.Spec.VolumeName),
+		})
+	case corev1.ClaimPending:
+		waitForFirstConsumer := storageClass != nil && storageClass.VolumeBindingMode != nil && *storageClass.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer
+		switch {
+		case waitForFirstConsumer && len(consumers) == 0:
+			result.Findings = append(result.Findings, Finding{
+				Type:     "WaitingForFirstConsumer",
+				Severity: severityInfo,
+				Message:  fmt.Sprintf("The storage class %s uses WaitForFirstConsumer binding, the claim stays pending until a pod using it is scheduled", storageClass.Name),
+			})
+		case waitForFirstConsumer && len(unschedulable) > 0:
+			result.Findings = append(result.Findings, Finding{
+				Type:     "ConsumerUnschedulable",
+				Severity: severityCritical,
+				Message:  fmt.Sprintf("The storage class %s uses WaitForFirstConsumer binding, but the pods using the claim cannot be scheduled. Use diagnose_pod to find out why", storageClass.Name),
+				Evidence: unschedulable,
+			})
+		case waitForFirstConsumer && claim.Annotations[selectedNodeAnnotation] != "":
+			result.Findings = append(result.Findings, Finding{
+				Type:     "ProvisioningOnSelectedNode",
+				Severity: severityInfo,
+				Message:  fmt.Sprintf("The scheduler selected node %s, waiting for the provisioner to create a volume there", claim.Annotations[selectedNodeAnnotation]),
+				Evidence: eventEvidence(result.Events, "ExternalProvisioning", "Provisioning"),
+			})
+		}
+	}
+
+	// Static binding, either to a named volume or to any matching volume when nothing provisions one.
+	if claim.Status.Phase == corev1.ClaimPending {
+		className := ""
+		if storageClass != nil {
+			className = storageClass.Name
+		} else if claim.Spec.StorageClassName != nil {
+			className = *claim.Spec.StorageClassName
+		}
+
+		if claim.Spec.VolumeName != "" {
+			volume, err := kubernetesApiClient.CoreV1().PersistentVolumes().Get(ctx, claim.Spec.VolumeName, metav1.GetOptions{})
+			switch {
+			case apierrors.IsNotFound(err):
+				result.Findings = append(result.Findings, Finding{
+					Type:     "MissingVolume",
+					Severity: severityCritical,
+					Message:  fmt.Sprintf("The claim requests the persistent volume %s, which does not exist", claim.Spec.VolumeName),
+				})
+			case err != nil:
+				result.Errors = append(result.Errors, fmt.Sprintf("persistentvolume %s: %v", claim.Spec.VolumeName, err))
+			default:
+				if mismatch := volumeMismatch(volume, claim, className); mismatch != "" {
+					result.Findings = append(result.Findings, Finding{
+						Type:     "VolumeMismatch",
+						Severity: severityCritical,
+						Message:  fmt.Sprintf("The claim requests the persistent volume %s, which cannot bind to it", volume.Name),
+						Evidence: []string{mismatch},
+					})
+				}
+			}
+		} else if storageClass == nil || storageClass.Provisioner == noProvisioner {
+			volumes, err := kubernetesApiClient.CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
+			if err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("persistentvolumes: %v", err))
+			} else {
+				mismatches := []string{}
+				capacityMismatch := false
+				for i := range volumes.Items {
+					volume := &volumes.Items[i]
+					if volume.Spec.StorageClassName != className {
+						continue
+					}
+					mismatch := volumeMismatch(volume, claim, className)
+					if mismatch == "" {
+						result.MatchingVolumes = append(result.MatchingVolumes, volume.Name)
+						continue
+					}
+					capacityMismatch = capacityMismatch || strings.HasPrefix(mismatch, "capacity")
+					mismatches = append(mismatches, fmt.Sprintf("persistent volume %s: %s", volume.Name, mismatch))
+				}
+				if len(result.MatchingVolumes) == 0 {
+					finding := Finding{
+						Type:     "NoMatchingVolume",
+						Severity: severityCritical,
+						Message:  fmt.Sprintf("No volume is provisioned dynamically for storage class %q and no available persistent volume matches the claim", className),
+						Evidence: mismatches,
+					}
+					if capacityMismatch {
+						finding.Type = "CapacityMismatch"
+						finding.Message = fmt.Sprintf("No available persistent volume in storage class %q is large enough for the requested %s", className, result.Requested)
+					}
+					result.Findings = append(result.Findings, finding)
+				}
+			}
+		}
+	}
+
+	// Bound claims with less capacity than requested are waiting on an expansion.
+	if capacity, ok := claim.Status.Capacity[corev1.ResourceStorage]; ok && claim.Status.Phase == corev1.ClaimBound && capacity.Cmp(requested) < 0 {
+		finding := Finding{
+			Type:     "ExpansionPending",
+			Severity: severityWarning,
+			Message:  fmt.Sprintf("The claim requests %s but its volume has a capacity of %s", requested.String(), capacity.String()),
+			Evidence: eventEvidence(result.Events, "VolumeResizeFailed", "ExternalExpanding", "Resizing"),
+		}
+		for _, condition := range claim.Status.Conditions {
+			finding.Evidence = append(finding.Evidence, fmt.Sprintf("condition %s=%s: %s", condition.Type, condition.Status, condition.Message))
+		}
+		if storageClass != nil && (storageClass.AllowVolumeExpansion == nil || !*storageClass.AllowVolumeExpansion) {
+			finding.Severity = severityCritical
+			finding.Message += fmt.Sprintf(", and storage class %s does not allow volume expansion", storageClass.Name)
+		}
+		result.Findings = append(result.Findings, finding)
+	}
+
+	sortFindings(result.Findings)
+	result.Healthy = claim.Status.Phase == corev1.ClaimBound && !hasProblemFindings(result.Findings)
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal persistent volume claim diagnosis", "pvc", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,66 @@
+package tools
+
+import (
+	"github.com/pmezard/go-difflib/difflib"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// serverPopulatedMetadataFields are metadata fields set by the API server
+// which are excluded when diffing objects.
+var serverPopulatedMetadataFields = []string{
+	"managedFields",
+	"resourceVersion",
+	"uid",
+	"generation",
+	"creationTimestamp",
+	"selfLink",
+}
+
+// sanitiseForDiff returns a copy of the object without status and server
+// populated metadata, or nil if the object is nil.
+func sanitiseForDiff(object *unstructured.Unstructured) map[string]any {
+	if object == nil {
+		return nil
+	}
+
+	sanitised := object.DeepCopy()
+	unstructured.RemoveNestedField(sanitised.Object, "status")
+	for _, field := range serverPopulatedMetadataFields {
+		unstructured.RemoveNestedField(sanitised.Object, "metadata", field)
+	}
+	return sanitised.Object
+}
+
+// unifiedObjectDiff returns a unified diff between the YAML representations
+// of two objects. A nil object is treated as empty (e.g. not yet created).
+func unifiedObjectDiff(name string, from, to *unstructured.Unstructured) (string, error) {
+	fromYaml, err := objectYaml(sanitiseForDiff(from))
+	if err != nil {
+		return "", err
+	}
+	toYaml, err := objectYaml(sanitiseForDiff(to))
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(fromYaml),
+		B:        difflib.SplitLines(toYaml),
+		FromFile: "live/" + name,
+		ToFile:   "merged/" + name,
+		Context:  3,
+	})
+}
+
+func objectYaml(object map[string]any) (string, error) {
+	if object == nil {
+		return "", nil
+	}
+	objectYaml, err := yaml.Marshal(object)
+	if err != nil {
+		return "", err
+	}
+	return string(objectYaml), nil
+}
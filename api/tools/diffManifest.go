@@ -0,0 +1,182 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	diffMethodServerSide = "server-side-dry-run"
+	diffMethodClientSide = "client-side"
+)
+
+var DiffManifestTool = &mcp.Tool{
+	Name:        "diff_manifest",
+	Description: "Diff a multi-document YAML manifest against the live objects in the Kubernetes cluster. Uses a server-side apply dry run so defaults and admission changes are included, ignoring status and server populated metadata. Falls back to a client-side diff of the manifest's fields when a dry run is not permitted, the method field of each object shows which was used. Never modifies the cluster",
+}
+
+type DiffManifestToolParams struct {
+	Manifest  string  `json:"manifest" jsonschema:"The YAML or JSON manifest to diff, may contain multiple documents"`
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace for namespaced objects which do not specify one, defaults to 'default'"`
+}
+
+type DiffManifestObjectResult struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace,omitempty"`
+	Action     string `json:"action,omitempty"`
+	Method     string `json:"method,omitempty"`
+	Diff       string `json:"diff,omitempty"`
+	Note       string `json:"note,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+type DiffManifestResult struct {
+	Changed int                        `json:"changed"`
+	Objects []DiffManifestObjectResult `json:"objects"`
+}
+
+// pruneToFields returns the parts of a live value which are also set in a
+// desired value, so that a client-side diff only covers the fields in a manifest.
+func pruneToFields(live any, desired any) any {
+	switch desiredTyped := desired.(type) {
+	case map[string]any:
+		liveTyped, ok := live.(map[string]any)
+		if !ok {
+			return live
+		}
+		pruned := map[string]any{}
+		for key, desiredValue := range desiredTyped {
+			if liveValue, ok := liveTyped[key]; ok {
+				pruned[key] = pruneToFields(liveValue, desiredValue)
+			}
+		}
+		return pruned
+	case []any:
+		liveTyped, ok := live.([]any)
+		if !ok {
+			return live
+		}
+		pruned := make([]any, len(liveTyped))
+		for i, liveValue := range liveTyped {
+			pruned[i] = liveValue
+			if i < len(desiredTyped) {
+				pruned[i] = pruneToFields(liveValue, desiredTyped[i])
+			}
+		}
+		return pruned
+	}
+	return live
+}
+
+// diffObject diffs an object against its live state using a server-side apply
+// dry run. If RBAC does not permit the dry run, e.g. because only read access
+// is granted, it falls back to diffing the fields set in the manifest.
+func diffObject(ctx context.Context, object *unstructured.Unstructured, defaultNamespace string) DiffManifestObjectResult {
+	result := DiffManifestObjectResult{
+		APIVersion: object.GetAPIVersion(),
+		Kind:       object.GetKind(),
+		Name:       object.GetName(),
+		Method:     diffMethodServerSide,
+	}
+
+	mapping, resource, live, err := getApplyTarget(ctx, object, defaultNamespace)
+	result.Namespace = object.GetNamespace()
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	name := fmt.Sprintf("%s/%s", mapping.Resource.Resource, object.GetName())
+
+	// Only an RBAC denial falls back to a client-side diff. Other forbidden
+	// errors, e.g. admission webhook or quota rejections, are reported.
+	permitted, err := applyPermitted(ctx, mapping, object, live != nil)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	merged := object
+	if permitted {
+		// Conflicts with other field managers are forced as nothing is persisted,
+		// as kubectl diff --server-side --force-conflicts does.
+		merged, err = resource.Apply(ctx, object.GetName(), object, metav1.ApplyOptions{
+			FieldManager: applyFieldManager,
+			DryRun:       []string{metav1.DryRunAll},
+			Force:        true,
+		})
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+	} else {
+		result.Method = diffMethodClientSide
+		result.Note = "A server-side apply dry run is not permitted, so defaults and admission changes are not included and only the fields set in the manifest are compared"
+		if live != nil {
+			live = &unstructured.Unstructured{Object: pruneToFields(live.Object, object.Object).(map[string]any)}
+		}
+	}
+
+	result.Diff, err = unifiedObjectDiff(name, live, merged)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	switch {
+	case live == nil:
+		result.Action = "create"
+	case result.Diff == "":
+		result.Action = "unchanged"
+	default:
+		result.Action = "configure"
+	}
+	return result
+}
+
+func DiffManifestHandler(ctx context.Context, req *mcp.CallToolRequest, params DiffManifestToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := "default"
+	if params.Namespace != nil && *params.Namespace != "" {
+		namespace = *params.Namespace
+	}
+
+	objects, err := decodeManifest(params.Manifest)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(objects) == 0 {
+		return nil, nil, errors.New("manifest does not contain any objects")
+	}
+
+	result := DiffManifestResult{Objects: []DiffManifestObjectResult{}}
+	for _, object := range objects {
+		objectResult := diffObject(ctx, object, namespace)
+		if objectResult.Error != "" {
+			slog.Error("Failed to diff manifest object", "tool", req.Params.Name, "kind", objectResult.Kind, "name", objectResult.Name, "namespace", objectResult.Namespace, "error", objectResult.Error)
+		} else if objectResult.Action != "unchanged" {
+			result.Changed++
+		}
+		result.Objects = append(result.Objects, objectResult)
+	}
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal manifest diff", "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
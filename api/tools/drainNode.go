@@ -0,0 +1,244 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+const (
+	mirrorPodAnnotation       = "kubernetes.io/config.mirror"
+	defaultDrainTimeout       = 5 * time.Minute
+	drainEvictionRetryPeriod  = 5 * time.Second
+	drainDeletionPollInterval = 2 * time.Second
+)
+
+var DrainNodeTool = &mcp.Tool{
+	Name:        "drain_node",
+	Description: "Cordon a node in the Kubernetes cluster and evict its pods using the Eviction API, skipping DaemonSet and mirror pods. Sends progress notifications while the drain runs",
+}
+
+type DrainNodeToolParams struct {
+	Name               string `json:"name" jsonschema:"The name of the node"`
+	DeleteEmptyDirData bool   `json:"deleteEmptyDirData,omitempty" jsonschema:"Continue even if there are pods using emptyDir volumes, whose data will be lost"`
+	Force              bool   `json:"force,omitempty" jsonschema:"Continue even if there are pods not managed by a controller, which will not be recreated"`
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty" jsonschema:"The grace period in seconds given to each pod, defaults to the pod's terminationGracePeriodSeconds"`
+	TimeoutSeconds     *int64 `json:"timeoutSeconds,omitempty" jsonschema:"The time in seconds to wait for the drain to complete, defaults to 300"`
+}
+
+type DrainNodePodResult struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+type DrainNodeResult struct {
+	Name     string               `json:"name"`
+	Cordoned bool                 `json:"cordoned"`
+	Complete bool                 `json:"complete"`
+	TimedOut bool                 `json:"timedOut"`
+	Evicted  []DrainNodePodResult `json:"evicted"`
+	Skipped  []DrainNodePodResult `json:"skipped"`
+	Failed   []DrainNodePodResult `json:"failed"`
+}
+
+// drainPodFilter decides whether a pod should be evicted during a drain, following
+// `kubectl drain` semantics. It returns whether to evict, a skip reason and a blocking error.
+func drainPodFilter(ctx context.Context, pod *corev1.Pod, params DrainNodeToolParams) (bool, string, error) {
+	if _, ok := pod.Annotations[mirrorPodAnnotation]; ok {
+		return false, "mirror pod", nil
+	}
+
+	owner := metav1.GetControllerOf(pod)
+	if owner != nil && owner.Kind == workloadKindDaemonSet {
+		// Pods of a deleted DaemonSet are orphaned and treated like unmanaged pods.
+		_, err := kubernetesApiClient.AppsV1().DaemonSets(pod.Namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+		if err == nil {
+			return false, "managed by DaemonSet", nil
+		}
+		if !apierrors.IsNotFound(err) {
+			return false, "", fmt.Errorf("failed to get DaemonSet %s: %w", owner.Name, err)
+		}
+		owner = nil
+	}
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return true, "", nil
+	}
+	if owner == nil && !params.Force {
+		return false, "", errors.New("pod is not managed by a controller, set force to evict it")
+	}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.EmptyDir != nil && !params.DeleteEmptyDirData {
+			return false, "", errors.New("pod uses emptyDir volumes, set deleteEmptyDirData to evict it")
+		}
+	}
+	return true, "", nil
+}
+
+// waitForPodDeletion waits until the pod no longer exists or has been replaced.
+func waitForPodDeletion(ctx context.Context, pod corev1.Pod) error {
+	for {
+		current, err := kubernetesApiClient.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) || (err == nil && current.UID != pod.UID) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(drainDeletionPollInterval):
+		}
+	}
+}
+
+func DrainNodeHandler(ctx context.Context, req *mcp.CallToolRequest, params DrainNodeToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	timeout := defaultDrainTimeout
+	if params.TimeoutSeconds != nil && *params.TimeoutSeconds > 0 {
+		timeout = time.Duration(*params.TimeoutSeconds) * time.Second
+	}
+
+	if _, err := kubernetesApiClient.CoreV1().Nodes().Get(ctx, params.Name, metav1.GetOptions{}); err != nil {
+		slog.Error("Failed to get node from Kubernetes API", "tool", req.Params.Name, "node", params.Name, "error", err)
+		return nil, nil, err
+	}
+
+	pods, err := kubernetesApiClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", params.Name).String(),
+	})
+	if err != nil {
+		slog.Error("Failed to list node pods from Kubernetes API", "tool", req.Params.Name, "node", params.Name, "error", err)
+		return nil, nil, err
+	}
+
+	result := DrainNodeResult{
+		Name:    params.Name,
+		Evicted: []DrainNodePodResult{},
+		Skipped: []DrainNodePodResult{},
+		Failed:  []DrainNodePodResult{},
+	}
+
+	// Check all pods can be evicted before making any changes.
+	toEvict := []corev1.Pod{}
+	blocking := []string{}
+	for _, pod := range pods.Items {
+		evict, reason, err := drainPodFilter(ctx, &pod, params)
+		if err != nil {
+			blocking = append(blocking, fmt.Sprintf("%s/%s: %v", pod.Namespace, pod.Name, err))
+			continue
+		}
+		if !evict {
+			result.Skipped = append(result.Skipped, DrainNodePodResult{Name: pod.Name, Namespace: pod.Namespace, Reason: reason})
+			continue
+		}
+		toEvict = append(toEvict, pod)
+	}
+	if len(blocking) > 0 {
+		return nil, nil, fmt.Errorf("cannot drain node %s:\n%s", params.Name, strings.Join(blocking, "\n"))
+	}
+
+	action := fmt.Sprintf("drain node %s, evicting %d pods", params.Name, len(toEvict))
+	if err := confirmAction(ctx, req, action); err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := setNodeUnschedulable(ctx, params.Name, true); err != nil {
+		slog.Error("Failed to cordon node", "tool", req.Params.Name, "node", params.Name, "error", err)
+		return nil, nil, err
+	}
+	result.Cordoned = true
+
+	slog.Info("Draining node", "node", params.Name, "pods", len(toEvict), "timeout", timeout)
+
+	drainCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Each pod is reported once when evicted and again once terminated.
+	total := float64(len(toEvict) * 2)
+	progress := 0.0
+	notifyProgress(ctx, req, progress, total, fmt.Sprintf("Node %s cordoned, evicting %d pods", params.Name, len(toEvict)))
+
+	// Evict pods, retrying those blocked by a disruption budget until the timeout.
+	evicted := []corev1.Pod{}
+	pending := toEvict
+	for len(pending) > 0 {
+		blocked := []corev1.Pod{}
+		for _, pod := range pending {
+			eviction, err := evictPod(drainCtx, &pod, params.GracePeriodSeconds)
+			switch {
+			case apierrors.IsNotFound(err):
+				evicted = append(evicted, pod)
+			case err != nil:
+				result.Failed = append(result.Failed, DrainNodePodResult{Name: pod.Name, Namespace: pod.Namespace, Reason: err.Error()})
+				continue
+			case eviction.BlockedByDisruptionBudget:
+				blocked = append(blocked, pod)
+				continue
+			default:
+				evicted = append(evicted, pod)
+			}
+			progress++
+			notifyProgress(ctx, req, progress, total, fmt.Sprintf("Evicted pod %s/%s", pod.Namespace, pod.Name))
+		}
+
+		pending = blocked
+		if len(pending) == 0 {
+			break
+		}
+
+		select {
+		case <-drainCtx.Done():
+		case <-time.After(drainEvictionRetryPeriod):
+		}
+		if drainCtx.Err() != nil {
+			result.TimedOut = true
+			for _, pod := range pending {
+				result.Failed = append(result.Failed, DrainNodePodResult{Name: pod.Name, Namespace: pod.Namespace, Reason: "eviction blocked by PodDisruptionBudget"})
+			}
+			break
+		}
+	}
+
+	// Wait for evicted pods to terminate.
+	for _, pod := range evicted {
+		if err := waitForPodDeletion(drainCtx, pod); err != nil {
+			if drainCtx.Err() != nil {
+				result.TimedOut = true
+			}
+			result.Failed = append(result.Failed, DrainNodePodResult{Name: pod.Name, Namespace: pod.Namespace, Reason: fmt.Sprintf("evicted but not terminated: %v", err)})
+			continue
+		}
+		result.Evicted = append(result.Evicted, DrainNodePodResult{Name: pod.Name, Namespace: pod.Namespace})
+		progress++
+		notifyProgress(ctx, req, progress, total, fmt.Sprintf("Pod %s/%s terminated", pod.Namespace, pod.Name))
+	}
+
+	result.Complete = len(result.Failed) == 0
+	slog.Info("Node drain finished", "node", params.Name, "complete", result.Complete, "evicted", len(result.Evicted), "failed", len(result.Failed), "timedOut", result.TimedOut)
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,96 @@
+package tools
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type EventSummary struct {
+	Type      string `json:"type"`
+	Reason    string `json:"reason"`
+	Message   string `json:"message"`
+	Count     int32  `json:"count"`
+	FirstSeen string `json:"firstSeen,omitempty"`
+	LastSeen  string `json:"lastSeen,omitempty"`
+	Source    string `json:"source,omitempty"`
+}
+
+// eventFirstSeen returns the time an event was first observed.
+func eventFirstSeen(event *eventsv1.Event) time.Time {
+	if !event.DeprecatedFirstTimestamp.IsZero() {
+		return event.DeprecatedFirstTimestamp.Time
+	}
+	return event.EventTime.Time
+}
+
+// eventLastSeen returns the most recent time an event was observed.
+func eventLastSeen(event *eventsv1.Event) time.Time {
+	switch {
+	case event.Series != nil && !event.Series.LastObservedTime.IsZero():
+		return event.Series.LastObservedTime.Time
+	case !event.DeprecatedLastTimestamp.IsZero():
+		return event.DeprecatedLastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.DeprecatedFirstTimestamp.Time
+	}
+}
+
+// eventCount returns the number of times an event was observed.
+func eventCount(event *eventsv1.Event) int32 {
+	count := event.DeprecatedCount
+	if event.Series != nil && event.Series.Count > count {
+		count = event.Series.Count
+	}
+	return max(count, 1)
+}
+
+func summariseEvent(event *eventsv1.Event) EventSummary {
+	source := event.ReportingController
+	if source == "" {
+		source = event.DeprecatedSource.Component
+	}
+
+	summary := EventSummary{
+		Type:    event.Type,
+		Reason:  event.Reason,
+		Message: event.Note,
+		Count:   eventCount(event),
+		Source:  source,
+	}
+	if firstSeen := eventFirstSeen(event); !firstSeen.IsZero() {
+		summary.FirstSeen = firstSeen.UTC().Format(time.RFC3339)
+	}
+	if lastSeen := eventLastSeen(event); !lastSeen.IsZero() {
+		summary.LastSeen = lastSeen.UTC().Format(time.RFC3339)
+	}
+	return summary
+}
+
+// listObjectEvents lists the events for the object with the given UID, oldest first.
+// An empty namespace searches all namespaces, e.g. for cluster scoped objects.
+func listObjectEvents(ctx context.Context, namespace string, uid types.UID) ([]EventSummary, error) {
+	events, err := kubernetesApiClient.EventsV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("regarding.uid", string(uid)).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(events.Items, func(a, b eventsv1.Event) int {
+		return eventLastSeen(&a).Compare(eventLastSeen(&b))
+	})
+
+	summaries := []EventSummary{}
+	for i := range events.Items {
+		summaries = append(summaries, summariseEvent(&events.Items[i]))
+	}
+	return summaries, nil
+}
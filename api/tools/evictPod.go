@@ -0,0 +1,128 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var EvictPodTool = &mcp.Tool{
+	Name:        "evict_pod",
+	Description: "Evict a pod in the Kubernetes cluster using the Eviction API, honouring any PodDisruptionBudgets",
+}
+
+type EvictPodToolParams struct {
+	Name               string `json:"name" jsonschema:"The name of the pod"`
+	Namespace          string `json:"namespace" jsonschema:"The namespace of the pod"`
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty" jsonschema:"The grace period in seconds before the pod is terminated, defaults to the pod's terminationGracePeriodSeconds"`
+}
+
+type EvictPodResult struct {
+	Name                      string   `json:"name"`
+	Namespace                 string   `json:"namespace"`
+	Evicted                   bool     `json:"evicted"`
+	BlockedByDisruptionBudget bool     `json:"blockedByDisruptionBudget"`
+	DisruptionBudgets         []string `json:"disruptionBudgets,omitempty"`
+	Message                   string   `json:"message,omitempty"`
+}
+
+// evictPod evicts a pod via the Eviction subresource. If a PodDisruptionBudget
+// prevents the eviction the returned result reports it rather than an error.
+func evictPod(ctx context.Context, pod *corev1.Pod, gracePeriodSeconds *int64) (EvictPodResult, error) {
+	result := EvictPodResult{
+		Name:      pod.Name,
+		Namespace: pod.Namespace,
+	}
+
+	err := kubernetesApiClient.PolicyV1().Evictions(pod.Namespace).Evict(ctx, &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+		},
+		DeleteOptions: &metav1.DeleteOptions{
+			GracePeriodSeconds: gracePeriodSeconds,
+			Preconditions:      metav1.NewUIDPreconditions(string(pod.UID)),
+		},
+	})
+	if apierrors.IsTooManyRequests(err) {
+		// The API server rejects evictions which would violate a disruption budget with a 429.
+		result.BlockedByDisruptionBudget = true
+		result.Message = err.Error()
+		result.DisruptionBudgets, err = matchingDisruptionBudgets(ctx, pod)
+		return result, err
+	}
+	if err != nil {
+		return result, err
+	}
+
+	result.Evicted = true
+	return result, nil
+}
+
+// matchingDisruptionBudgets returns the names of the PodDisruptionBudgets which select the pod.
+func matchingDisruptionBudgets(ctx context.Context, pod *corev1.Pod) ([]string, error) {
+	budgets, err := kubernetesApiClient.PolicyV1().PodDisruptionBudgets(pod.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, budget := range budgets.Items {
+		// A nil selector selects no pods, an empty selector selects all pods in the namespace.
+		selector, err := metav1.LabelSelectorAsSelector(budget.Spec.Selector)
+		if err != nil {
+			continue
+		}
+		if selector.Matches(labels.Set(pod.Labels)) {
+			names = append(names, budget.Name)
+		}
+	}
+	return names, nil
+}
+
+func EvictPodHandler(ctx context.Context, req *mcp.CallToolRequest, params EvictPodToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	if params.GracePeriodSeconds != nil && *params.GracePeriodSeconds < 0 {
+		return nil, nil, fmt.Errorf("gracePeriodSeconds must not be negative, got %d", *params.GracePeriodSeconds)
+	}
+
+	pod, err := kubernetesApiClient.CoreV1().Pods(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get pod from Kubernetes API", "tool", req.Params.Name, "pod", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	action := fmt.Sprintf("evict pod %s in namespace %s", params.Name, params.Namespace)
+	if err := confirmAction(ctx, req, action); err != nil {
+		return nil, nil, err
+	}
+
+	result, err := evictPod(ctx, pod, params.GracePeriodSeconds)
+	if err != nil {
+		slog.Error("Failed to evict pod", "tool", req.Params.Name, "pod", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	slog.Info("Pod eviction requested", "pod", params.Name, "namespace", params.Namespace, "evicted", result.Evicted, "blockedByDisruptionBudget", result.BlockedByDisruptionBudget)
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
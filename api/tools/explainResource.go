@@ -0,0 +1,216 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/openapi3"
+	"k8s.io/kube-openapi/pkg/spec3"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+// openAPISchemaRefPrefix prefixes references to component schemas in OpenAPI v3 documents.
+const openAPISchemaRefPrefix = "#/components/schemas/"
+
+// maxSchemaResolveDepth bounds schema reference walks to guard against reference cycles.
+const maxSchemaResolveDepth = 10
+
+var ExplainResourceTool = &mcp.Tool{
+	Name:        "explain_resource",
+	Description: "Explain the fields of a resource kind from the Kubernetes cluster's OpenAPI v3 schema, similar to kubectl explain. Works for custom resources",
+}
+
+type ExplainResourceToolParams struct {
+	Kind       string  `json:"kind" jsonschema:"The kind or resource name, e.g. Deployment, deployments.apps or certificates.cert-manager.io"`
+	FieldPath  *string `json:"fieldPath,omitempty" jsonschema:"A dot separated path to a field to explain, e.g. spec.template.spec.containers. Defaults to the top level fields"`
+	APIVersion *string `json:"apiVersion,omitempty" jsonschema:"The API version of the kind, e.g. apps/v1, to disambiguate kinds served by multiple groups"`
+}
+
+type ExplainedField struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Required    bool   `json:"required,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type ExplainResourceResult struct {
+	Group       string           `json:"group"`
+	Version     string           `json:"version"`
+	Kind        string           `json:"kind"`
+	FieldPath   string           `json:"fieldPath,omitempty"`
+	Type        string           `json:"type"`
+	Description string           `json:"description,omitempty"`
+	Fields      []ExplainedField `json:"fields,omitempty"`
+}
+
+// resolveSchema follows references, single element allOf wrappers and
+// array or map items until reaching a schema with fields or a primitive type.
+// The description of the outermost schema is kept, as descriptions on a field
+// are more specific than on the type it references.
+func resolveSchema(document *spec3.OpenAPI, fieldSchema *spec.Schema) *spec.Schema {
+	description := fieldSchema.Description
+	for range maxSchemaResolveDepth {
+		var next *spec.Schema
+		switch {
+		case fieldSchema.Ref.String() != "":
+			next = document.Components.Schemas[strings.TrimPrefix(fieldSchema.Ref.String(), openAPISchemaRefPrefix)]
+		case len(fieldSchema.AllOf) == 1 && len(fieldSchema.Properties) == 0:
+			next = &fieldSchema.AllOf[0]
+		case fieldSchema.Items != nil && fieldSchema.Items.Schema != nil:
+			next = fieldSchema.Items.Schema
+		case fieldSchema.AdditionalProperties != nil && fieldSchema.AdditionalProperties.Schema != nil && len(fieldSchema.Properties) == 0:
+			next = fieldSchema.AdditionalProperties.Schema
+		}
+		if next == nil {
+			break
+		}
+		fieldSchema = next
+	}
+
+	if description == "" || description == fieldSchema.Description {
+		return fieldSchema
+	}
+	resolved := *fieldSchema
+	resolved.Description = description
+	return &resolved
+}
+
+// describeSchemaType returns a kubectl explain style type, e.g. []Container or map[string]string.
+func describeSchemaType(fieldSchema *spec.Schema) string {
+	if ref := fieldSchema.Ref.String(); ref != "" {
+		name := strings.TrimPrefix(ref, openAPISchemaRefPrefix)
+		return name[strings.LastIndex(name, ".")+1:]
+	}
+	if len(fieldSchema.AllOf) == 1 {
+		return describeSchemaType(&fieldSchema.AllOf[0])
+	}
+	if fieldSchema.Items != nil && fieldSchema.Items.Schema != nil {
+		return "[]" + describeSchemaType(fieldSchema.Items.Schema)
+	}
+	if fieldSchema.AdditionalProperties != nil && fieldSchema.AdditionalProperties.Schema != nil {
+		return "map[string]" + describeSchemaType(fieldSchema.AdditionalProperties.Schema)
+	}
+	if len(fieldSchema.Type) > 0 {
+		return fieldSchema.Type[0]
+	}
+	if fieldSchema.Extensions != nil {
+		if intOrString, _ := fieldSchema.Extensions.GetBool("x-kubernetes-int-or-string"); intOrString {
+			return "int-or-string"
+		}
+	}
+	return "Object"
+}
+
+// findKindSchema returns the schema for a kind from its group version's OpenAPI document.
+func findKindSchema(document *spec3.OpenAPI, gvk schema.GroupVersionKind) (*spec.Schema, error) {
+	if document.Components == nil {
+		return nil, fmt.Errorf("OpenAPI document for %s has no schemas", gvk.GroupVersion())
+	}
+	for _, candidate := range document.Components.Schemas {
+		extension, ok := candidate.Extensions["x-kubernetes-group-version-kind"].([]any)
+		if !ok {
+			continue
+		}
+		for _, item := range extension {
+			kind, ok := item.(map[string]any)
+			if ok && kind["group"] == gvk.Group && kind["version"] == gvk.Version && kind["kind"] == gvk.Kind {
+				return candidate, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no OpenAPI schema found for %s", gvk)
+}
+
+func ExplainResourceHandler(ctx context.Context, req *mcp.CallToolRequest, params ExplainResourceToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	apiVersion := ""
+	if params.APIVersion != nil {
+		apiVersion = *params.APIVersion
+	}
+	fieldPath := ""
+	if params.FieldPath != nil {
+		fieldPath = strings.Trim(*params.FieldPath, ".")
+	}
+
+	mapping, err := resolveKindMapping(apiVersion, params.Kind)
+	if err != nil {
+		slog.Error("Failed to resolve resource kind", "tool", req.Params.Name, "kind", params.Kind, "error", err)
+		return nil, nil, err
+	}
+	gvk := mapping.GroupVersionKind
+
+	root := openapi3.NewRoot(kubernetesCachedDiscoveryClient.OpenAPIV3())
+	document, err := root.GVSpec(gvk.GroupVersion())
+	var notFound *openapi3.GroupVersionNotFoundError
+	if errors.As(err, &notFound) {
+		// The group version may have been installed since the OpenAPI paths were cached.
+		kubernetesCachedDiscoveryClient.Invalidate()
+		root = openapi3.NewRoot(kubernetesCachedDiscoveryClient.OpenAPIV3())
+		document, err = root.GVSpec(gvk.GroupVersion())
+	}
+	if err != nil {
+		slog.Error("Failed to get OpenAPI schema from Kubernetes API", "tool", req.Params.Name, "groupVersion", gvk.GroupVersion().String(), "error", err)
+		return nil, nil, err
+	}
+
+	current, err := findKindSchema(document, gvk)
+	if err != nil {
+		return nil, nil, err
+	}
+	fieldType := gvk.Kind
+	if fieldPath != "" {
+		for field := range strings.SplitSeq(fieldPath, ".") {
+			parent := resolveSchema(document, current)
+			property, ok := parent.Properties[field]
+			if !ok {
+				return nil, nil, fmt.Errorf("field %q does not exist in %s %s", field, gvk.Kind, fieldPath)
+			}
+			fieldType = describeSchemaType(&property)
+			current = &property
+		}
+	}
+	current = resolveSchema(document, current)
+
+	result := ExplainResourceResult{
+		Group:       gvk.Group,
+		Version:     gvk.Version,
+		Kind:        gvk.Kind,
+		FieldPath:   fieldPath,
+		Type:        fieldType,
+		Description: current.Description,
+		Fields:      []ExplainedField{},
+	}
+	for name, property := range current.Properties {
+		result.Fields = append(result.Fields, ExplainedField{
+			Name:        name,
+			Type:        describeSchemaType(&property),
+			Required:    slices.Contains(current.Required, name),
+			Description: resolveSchema(document, &property).Description,
+		})
+	}
+	slices.SortFunc(result.Fields, func(a, b ExplainedField) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal resource explanation", "kind", gvk.Kind, "fieldPath", fieldPath, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,51 @@
+package tools
+
+import (
+	"cmp"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	severityCritical = "critical"
+	severityWarning  = "warning"
+	severityInfo     = "info"
+)
+
+var severityOrder = map[string]int{
+	severityCritical: 0,
+	severityWarning:  1,
+	severityInfo:     2,
+}
+
+// Finding is a problem found when analysing a resource, ordered by severity.
+type Finding struct {
+	Type      string   `json:"type"`
+	Severity  string   `json:"severity"`
+	Container string   `json:"container,omitempty"`
+	Message   string   `json:"message"`
+	Evidence  []string `json:"evidence,omitempty"`
+}
+
+// sortFindings orders findings from most to least severe.
+func sortFindings(findings []Finding) {
+	slices.SortStableFunc(findings, func(a, b Finding) int {
+		return cmp.Compare(severityOrder[a.Severity], severityOrder[b.Severity])
+	})
+}
+
+// hasProblemFindings checks whether any findings are more severe than informational.
+func hasProblemFindings(findings []Finding) bool {
+	return slices.ContainsFunc(findings, func(finding Finding) bool { return finding.Severity != severityInfo })
+}
+
+// isPodReady checks whether a pod has a true Ready condition.
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
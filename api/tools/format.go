@@ -0,0 +1,24 @@
+package tools
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// formatAge returns the human readable age of a timestamp, as shown by kubectl.
+func formatAge(timestamp metav1.Time) string {
+	if timestamp.IsZero() {
+		return ""
+	}
+	return duration.HumanDuration(time.Since(timestamp.Time))
+}
+
+// formatTime returns an RFC3339 timestamp, or an empty string if unset.
+func formatTime(timestamp *metav1.Time) string {
+	if timestamp == nil || timestamp.IsZero() {
+		return ""
+	}
+	return timestamp.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,64 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	gatewayAPIGateways   = "gateways.gateway.networking.k8s.io"
+	gatewayAPIHTTPRoutes = "httproutes.gateway.networking.k8s.io"
+	gatewayAPIGRPCRoutes = "grpcroutes.gateway.networking.k8s.io"
+)
+
+// gatewayAPIResource returns a dynamic client for a Gateway API resource at the
+// version preferred by the cluster, explaining when the CRDs are not installed.
+func gatewayAPIResource(resource string, namespace string) (dynamic.ResourceInterface, error) {
+	mapping, err := resolveKindMapping("", resource)
+	if meta.IsNoMatchError(err) {
+		return nil, fmt.Errorf("the Gateway API resource %s is not installed in the cluster", resource)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resourceInterfaceFor(mapping, namespace), nil
+}
+
+// listGatewayAPIResources lists Gateway API objects, without their managed fields.
+func listGatewayAPIResources(ctx context.Context, req *mcp.CallToolRequest, resource string, namespace string) ([]map[string]any, error) {
+	client, err := gatewayAPIResource(resource, namespace)
+	if err != nil {
+		return nil, err
+	}
+	list, err := listAllPages(ctx, req, resource, metav1.ListOptions{}, client.List)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []map[string]any{}
+	for _, item := range list.Items {
+		item.SetManagedFields(nil)
+		objects = append(objects, item.Object)
+	}
+	return objects, nil
+}
+
+// getGatewayAPIResource gets a Gateway API object, without its managed fields.
+func getGatewayAPIResource(ctx context.Context, resource string, namespace string, name string) (map[string]any, error) {
+	client, err := gatewayAPIResource(resource, namespace)
+	if err != nil {
+		return nil, err
+	}
+	object, err := client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	object.SetManagedFields(nil)
+	return object.Object, nil
+}
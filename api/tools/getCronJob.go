@@ -0,0 +1,82 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var GetCronJobTool = &mcp.Tool{
+	Name:        "get_cron_job",
+	Description: "Get a cronjob in the Kubernetes cluster",
+}
+
+type GetCronJobToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the cronjob"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the cronjob"`
+	Summary   bool   `json:"summary,omitempty" jsonschema:"Return a summary of the schedule status instead of the full object"`
+}
+
+type CronJobSummary struct {
+	Name               string   `json:"name"`
+	Namespace          string   `json:"namespace"`
+	Schedule           string   `json:"schedule"`
+	TimeZone           string   `json:"timeZone,omitempty"`
+	Suspended          bool     `json:"suspended"`
+	ActiveJobs         []string `json:"activeJobs"`
+	LastScheduleTime   string   `json:"lastScheduleTime,omitempty"`
+	LastSuccessfulTime string   `json:"lastSuccessfulTime,omitempty"`
+	Age                string   `json:"age"`
+}
+
+func summariseCronJob(cronJob *batchv1.CronJob) CronJobSummary {
+	summary := CronJobSummary{
+		Name:               cronJob.Name,
+		Namespace:          cronJob.Namespace,
+		Schedule:           cronJob.Spec.Schedule,
+		Suspended:          cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend,
+		ActiveJobs:         []string{},
+		LastScheduleTime:   formatTime(cronJob.Status.LastScheduleTime),
+		LastSuccessfulTime: formatTime(cronJob.Status.LastSuccessfulTime),
+		Age:                formatAge(cronJob.CreationTimestamp),
+	}
+	if cronJob.Spec.TimeZone != nil {
+		summary.TimeZone = *cronJob.Spec.TimeZone
+	}
+	for _, job := range cronJob.Status.Active {
+		summary.ActiveJobs = append(summary.ActiveJobs, job.Name)
+	}
+	return summary
+}
+
+func GetCronJobHandler(ctx context.Context, req *mcp.CallToolRequest, params GetCronJobToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	cronJob, err := kubernetesApiClient.BatchV1().CronJobs(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get cronjob from Kubernetes API", "tool", req.Params.Name, "cronjob", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	var cronJobJson []byte
+	if params.Summary {
+		cronJobJson, err = json.Marshal(summariseCronJob(cronJob))
+	} else {
+		cronJobJson, err = json.Marshal(cronJob)
+	}
+	if err != nil {
+		slog.Error("Failed to marshal cronjob object", "cronjob", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(cronJobJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,42 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var GetCSIDriverTool = &mcp.Tool{
+	Name:        "get_csi_driver",
+	Description: "Get a CSI driver in the Kubernetes cluster",
+}
+
+type GetCSIDriverToolParams struct {
+	Name string `json:"name" jsonschema:"The name of the CSI driver"`
+}
+
+func GetCSIDriverHandler(ctx context.Context, req *mcp.CallToolRequest, params GetCSIDriverToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	csiDriver, err := kubernetesApiClient.StorageV1().CSIDrivers().Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get CSI driver from Kubernetes API", "tool", req.Params.Name, "name", params.Name, "error", err)
+		return nil, nil, err
+	}
+
+	csiDriverJson, err := json.Marshal(csiDriver)
+	if err != nil {
+		slog.Error("Failed to marshal CSI driver", "name", params.Name, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(csiDriverJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,81 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var GetDaemonSetTool = &mcp.Tool{
+	Name:        "get_daemon_set",
+	Description: "Get a daemonset in the Kubernetes cluster",
+}
+
+type GetDaemonSetToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the daemonset"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the daemonset"`
+	Summary   bool   `json:"summary,omitempty" jsonschema:"Return a summary of the rollout status instead of the full object"`
+}
+
+type DaemonSetSummary struct {
+	Name                   string `json:"name"`
+	Namespace              string `json:"namespace"`
+	DesiredNumberScheduled int32  `json:"desiredNumberScheduled"`
+	CurrentNumberScheduled int32  `json:"currentNumberScheduled"`
+	NumberReady            int32  `json:"numberReady"`
+	UpdatedNumberScheduled int32  `json:"updatedNumberScheduled"`
+	NumberAvailable        int32  `json:"numberAvailable"`
+	NumberMisscheduled     int32  `json:"numberMisscheduled"`
+	RolloutComplete        bool   `json:"rolloutComplete"`
+	Age                    string `json:"age"`
+}
+
+func summariseDaemonSet(daemonSet *appsv1.DaemonSet) DaemonSetSummary {
+	status := daemonSet.Status
+	return DaemonSetSummary{
+		Name:                   daemonSet.Name,
+		Namespace:              daemonSet.Namespace,
+		DesiredNumberScheduled: status.DesiredNumberScheduled,
+		CurrentNumberScheduled: status.CurrentNumberScheduled,
+		NumberReady:            status.NumberReady,
+		UpdatedNumberScheduled: status.UpdatedNumberScheduled,
+		NumberAvailable:        status.NumberAvailable,
+		NumberMisscheduled:     status.NumberMisscheduled,
+		RolloutComplete: status.ObservedGeneration >= daemonSet.Generation &&
+			status.UpdatedNumberScheduled == status.DesiredNumberScheduled &&
+			status.NumberAvailable == status.DesiredNumberScheduled,
+		Age: formatAge(daemonSet.CreationTimestamp),
+	}
+}
+
+func GetDaemonSetHandler(ctx context.Context, req *mcp.CallToolRequest, params GetDaemonSetToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	daemonSet, err := kubernetesApiClient.AppsV1().DaemonSets(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get daemonset from Kubernetes API", "tool", req.Params.Name, "daemonset", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	var daemonSetJson []byte
+	if params.Summary {
+		daemonSetJson, err = json.Marshal(summariseDaemonSet(daemonSet))
+	} else {
+		daemonSetJson, err = json.Marshal(daemonSet)
+	}
+	if err != nil {
+		slog.Error("Failed to marshal daemonset object", "daemonset", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(daemonSetJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,43 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var GetEndpointSliceTool = &mcp.Tool{
+	Name:        "get_endpoint_slice",
+	Description: "Get a endpoint slice in the Kubernetes cluster",
+}
+
+type GetEndpointSliceToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the endpoint slice"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the endpoint slice"`
+}
+
+func GetEndpointSliceHandler(ctx context.Context, req *mcp.CallToolRequest, params GetEndpointSliceToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	endpointSlice, err := kubernetesApiClient.DiscoveryV1().EndpointSlices(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get endpoint slice from Kubernetes API", "tool", req.Params.Name, "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	endpointSliceJson, err := json.Marshal(endpointSlice)
+	if err != nil {
+		slog.Error("Failed to marshal endpoint slice", "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(endpointSliceJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,41 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var GetGatewayTool = &mcp.Tool{
+	Name:        "get_gateway",
+	Description: "Get a Gateway API gateway in the Kubernetes cluster",
+}
+
+type GetGatewayToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the gateway"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the gateway"`
+}
+
+func GetGatewayHandler(ctx context.Context, req *mcp.CallToolRequest, params GetGatewayToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	gateway, err := getGatewayAPIResource(ctx, gatewayAPIGateways, params.Namespace, params.Name)
+	if err != nil {
+		slog.Error("Failed to get gateway from Kubernetes API", "tool", req.Params.Name, "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	gatewayJson, err := json.Marshal(gateway)
+	if err != nil {
+		slog.Error("Failed to marshal gateway", "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(gatewayJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,41 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var GetGRPCRouteTool = &mcp.Tool{
+	Name:        "get_grpc_route",
+	Description: "Get a Gateway API gRPC route in the Kubernetes cluster",
+}
+
+type GetGRPCRouteToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the gRPC route"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the gRPC route"`
+}
+
+func GetGRPCRouteHandler(ctx context.Context, req *mcp.CallToolRequest, params GetGRPCRouteToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	grpcRoute, err := getGatewayAPIResource(ctx, gatewayAPIGRPCRoutes, params.Namespace, params.Name)
+	if err != nil {
+		slog.Error("Failed to get gRPC route from Kubernetes API", "tool", req.Params.Name, "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	grpcRouteJson, err := json.Marshal(grpcRoute)
+	if err != nil {
+		slog.Error("Failed to marshal gRPC route", "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(grpcRouteJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,94 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/cturner8/kube-mcp/helm"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+var GetHelmReleaseTool = &mcp.Tool{
+	Name:        "get_helm_release",
+	Description: "Get a Helm release in the Kubernetes cluster, decoded from Helm's release secrets. Returns the chart, status, revision history, user-supplied values with secrets masked, and the rendered manifest. Requires list permission on secrets, which RBAC cannot limit to Helm's release secrets, so the server can then read every secret in the namespaces it lists",
+}
+
+type GetHelmReleaseToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the Helm release"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the Helm release"`
+	Revision  *int   `json:"revision,omitempty" jsonschema:"The revision of the release to get, defaults to the latest"`
+	Manifest  *bool  `json:"manifest,omitempty" jsonschema:"Include the rendered manifest, defaults to true"`
+}
+
+type HelmReleaseDetail struct {
+	HelmReleaseSummary
+	FirstDeployed string               `json:"firstDeployed,omitempty"`
+	Notes         string               `json:"notes,omitempty"`
+	Values        any                  `json:"values"`
+	Manifest      string               `json:"manifest,omitempty"`
+	History       []HelmReleaseSummary `json:"history"`
+	Errors        []string             `json:"errors,omitempty"`
+}
+
+func GetHelmReleaseHandler(ctx context.Context, req *mcp.CallToolRequest, params GetHelmReleaseToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	secrets, err := listHelmReleaseSecrets(ctx, req, params.Namespace, params.Name)
+	if err != nil {
+		slog.Error("Failed to list Helm release secrets from Kubernetes API", "tool", req.Params.Name, "release", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+	if len(secrets) == 0 {
+		return nil, nil, fmt.Errorf("helm release %s not found in namespace %s", params.Name, params.Namespace)
+	}
+	slices.SortFunc(secrets, func(a, b corev1.Secret) int {
+		return cmp.Compare(helmSecretRevision(&b), helmSecretRevision(&a))
+	})
+
+	var selected *helm.Release
+	detail := HelmReleaseDetail{History: []HelmReleaseSummary{}, Errors: []string{}}
+	for i := range secrets {
+		release, err := helm.DecodeRelease(&secrets[i])
+		if err != nil {
+			detail.Errors = append(detail.Errors, err.Error())
+			continue
+		}
+		detail.History = append(detail.History, summariseHelmRelease(release))
+		if selected == nil && (params.Revision == nil || *params.Revision == release.Version) {
+			selected = release
+		}
+	}
+	if selected == nil {
+		if params.Revision != nil {
+			return nil, nil, fmt.Errorf("revision %d of helm release %s not found in namespace %s", *params.Revision, params.Name, params.Namespace)
+		}
+		return nil, nil, fmt.Errorf("failed to decode helm release %s: %v", params.Name, detail.Errors)
+	}
+
+	detail.HelmReleaseSummary = summariseHelmRelease(selected)
+	detail.FirstDeployed = selected.Info.FirstDeployed
+	detail.Notes = selected.Info.Notes
+	detail.Values = helm.MaskValues(selected.Config, false)
+	if params.Manifest == nil || *params.Manifest {
+		detail.Manifest = helm.MaskManifestSecrets(selected.Manifest)
+	}
+
+	detailJson, err := json.Marshal(detail)
+	if err != nil {
+		slog.Error("Failed to marshal Helm release", "release", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(detailJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,210 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var GetHorizontalPodAutoscalerTool = &mcp.Tool{
+	Name:        "get_horizontal_pod_autoscaler",
+	Description: "Get a horizontal pod autoscaler in the Kubernetes cluster",
+}
+
+type GetHorizontalPodAutoscalerToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the horizontal pod autoscaler"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the horizontal pod autoscaler"`
+	Summary   bool   `json:"summary,omitempty" jsonschema:"Return a summary of the scaling status, metrics and conditions instead of the full object"`
+}
+
+type HorizontalPodAutoscalerMetric struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Current string `json:"current,omitempty"`
+	Target  string `json:"target"`
+}
+
+type HorizontalPodAutoscalerCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type HorizontalPodAutoscalerSummary struct {
+	Name            string                             `json:"name"`
+	Namespace       string                             `json:"namespace"`
+	ScaleTarget     string                             `json:"scaleTarget"`
+	MinReplicas     int32                              `json:"minReplicas"`
+	MaxReplicas     int32                              `json:"maxReplicas"`
+	CurrentReplicas int32                              `json:"currentReplicas"`
+	DesiredReplicas int32                              `json:"desiredReplicas"`
+	Metrics         []HorizontalPodAutoscalerMetric    `json:"metrics"`
+	Conditions      []HorizontalPodAutoscalerCondition `json:"conditions"`
+	LastScaleTime   string                             `json:"lastScaleTime,omitempty"`
+	Age             string                             `json:"age"`
+}
+
+// hpaMetricName identifies the metric of an HPA metric spec or status, so that they can be matched up.
+func hpaMetricName(metric autoscalingv2.MetricSpec) string {
+	switch {
+	case metric.Type == autoscalingv2.ResourceMetricSourceType && metric.Resource != nil:
+		return string(metric.Resource.Name)
+	case metric.Type == autoscalingv2.ContainerResourceMetricSourceType && metric.ContainerResource != nil:
+		return fmt.Sprintf("%s/%s", metric.ContainerResource.Container, metric.ContainerResource.Name)
+	case metric.Type == autoscalingv2.PodsMetricSourceType && metric.Pods != nil:
+		return metric.Pods.Metric.Name
+	case metric.Type == autoscalingv2.ObjectMetricSourceType && metric.Object != nil:
+		return fmt.Sprintf("%s on %s/%s", metric.Object.Metric.Name, metric.Object.DescribedObject.Kind, metric.Object.DescribedObject.Name)
+	case metric.Type == autoscalingv2.ExternalMetricSourceType && metric.External != nil:
+		return metric.External.Metric.Name
+	}
+	return ""
+}
+
+func hpaMetricStatusName(status autoscalingv2.MetricStatus) string {
+	switch {
+	case status.Type == autoscalingv2.ResourceMetricSourceType && status.Resource != nil:
+		return string(status.Resource.Name)
+	case status.Type == autoscalingv2.ContainerResourceMetricSourceType && status.ContainerResource != nil:
+		return fmt.Sprintf("%s/%s", status.ContainerResource.Container, status.ContainerResource.Name)
+	case status.Type == autoscalingv2.PodsMetricSourceType && status.Pods != nil:
+		return status.Pods.Metric.Name
+	case status.Type == autoscalingv2.ObjectMetricSourceType && status.Object != nil:
+		return fmt.Sprintf("%s on %s/%s", status.Object.Metric.Name, status.Object.DescribedObject.Kind, status.Object.DescribedObject.Name)
+	case status.Type == autoscalingv2.ExternalMetricSourceType && status.External != nil:
+		return status.External.Metric.Name
+	}
+	return ""
+}
+
+// hpaMetricTarget returns the target of an HPA metric spec.
+func hpaMetricTarget(metric autoscalingv2.MetricSpec) *autoscalingv2.MetricTarget {
+	switch {
+	case metric.Resource != nil:
+		return &metric.Resource.Target
+	case metric.ContainerResource != nil:
+		return &metric.ContainerResource.Target
+	case metric.Pods != nil:
+		return &metric.Pods.Target
+	case metric.Object != nil:
+		return &metric.Object.Target
+	case metric.External != nil:
+		return &metric.External.Target
+	}
+	return nil
+}
+
+// hpaMetricCurrent returns the current value of an HPA metric status.
+func hpaMetricCurrent(status autoscalingv2.MetricStatus) *autoscalingv2.MetricValueStatus {
+	switch {
+	case status.Resource != nil:
+		return &status.Resource.Current
+	case status.ContainerResource != nil:
+		return &status.ContainerResource.Current
+	case status.Pods != nil:
+		return &status.Pods.Current
+	case status.Object != nil:
+		return &status.Object.Current
+	case status.External != nil:
+		return &status.External.Current
+	}
+	return nil
+}
+
+// formatMetricValue formats a metric value the way kubectl get hpa does, as a utilisation percentage, average or total value.
+func formatMetricValue(utilization *int32, averageValue *resource.Quantity, value *resource.Quantity) string {
+	switch {
+	case utilization != nil:
+		return fmt.Sprintf("%d%%", *utilization)
+	case averageValue != nil:
+		return fmt.Sprintf("%s (average)", averageValue.String())
+	case value != nil:
+		return value.String()
+	}
+	return ""
+}
+
+func summariseHorizontalPodAutoscaler(hpa *autoscalingv2.HorizontalPodAutoscaler) HorizontalPodAutoscalerSummary {
+	minReplicas := int32(1)
+	if hpa.Spec.MinReplicas != nil {
+		minReplicas = *hpa.Spec.MinReplicas
+	}
+
+	summary := HorizontalPodAutoscalerSummary{
+		Name:            hpa.Name,
+		Namespace:       hpa.Namespace,
+		ScaleTarget:     fmt.Sprintf("%s/%s", hpa.Spec.ScaleTargetRef.Kind, hpa.Spec.ScaleTargetRef.Name),
+		MinReplicas:     minReplicas,
+		MaxReplicas:     hpa.Spec.MaxReplicas,
+		CurrentReplicas: hpa.Status.CurrentReplicas,
+		DesiredReplicas: hpa.Status.DesiredReplicas,
+		Metrics:         []HorizontalPodAutoscalerMetric{},
+		Conditions:      []HorizontalPodAutoscalerCondition{},
+		LastScaleTime:   formatTime(hpa.Status.LastScaleTime),
+		Age:             formatAge(hpa.CreationTimestamp),
+	}
+
+	current := map[string]string{}
+	for _, status := range hpa.Status.CurrentMetrics {
+		if value := hpaMetricCurrent(status); value != nil {
+			current[string(status.Type)+"/"+hpaMetricStatusName(status)] = formatMetricValue(value.AverageUtilization, value.AverageValue, value.Value)
+		}
+	}
+	for _, metric := range hpa.Spec.Metrics {
+		name := hpaMetricName(metric)
+		summaryMetric := HorizontalPodAutoscalerMetric{
+			Type:    string(metric.Type),
+			Name:    name,
+			Current: current[string(metric.Type)+"/"+name],
+		}
+		if target := hpaMetricTarget(metric); target != nil {
+			summaryMetric.Target = formatMetricValue(target.AverageUtilization, target.AverageValue, target.Value)
+		}
+		summary.Metrics = append(summary.Metrics, summaryMetric)
+	}
+
+	for _, condition := range hpa.Status.Conditions {
+		summary.Conditions = append(summary.Conditions, HorizontalPodAutoscalerCondition{
+			Type:    string(condition.Type),
+			Status:  string(condition.Status),
+			Reason:  condition.Reason,
+			Message: condition.Message,
+		})
+	}
+	return summary
+}
+
+func GetHorizontalPodAutoscalerHandler(ctx context.Context, req *mcp.CallToolRequest, params GetHorizontalPodAutoscalerToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	hpa, err := kubernetesApiClient.AutoscalingV2().HorizontalPodAutoscalers(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get horizontal pod autoscaler from Kubernetes API", "tool", req.Params.Name, "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	var hpaJson []byte
+	if params.Summary {
+		hpaJson, err = json.Marshal(summariseHorizontalPodAutoscaler(hpa))
+	} else {
+		hpaJson, err = json.Marshal(hpa)
+	}
+	if err != nil {
+		slog.Error("Failed to marshal horizontal pod autoscaler", "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(hpaJson)},
+		},
+	}, nil, nil
+}
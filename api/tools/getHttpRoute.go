@@ -0,0 +1,41 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var GetHTTPRouteTool = &mcp.Tool{
+	Name:        "get_http_route",
+	Description: "Get a Gateway API HTTP route in the Kubernetes cluster",
+}
+
+type GetHTTPRouteToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the HTTP route"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the HTTP route"`
+}
+
+func GetHTTPRouteHandler(ctx context.Context, req *mcp.CallToolRequest, params GetHTTPRouteToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	httpRoute, err := getGatewayAPIResource(ctx, gatewayAPIHTTPRoutes, params.Namespace, params.Name)
+	if err != nil {
+		slog.Error("Failed to get HTTP route from Kubernetes API", "tool", req.Params.Name, "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	httpRouteJson, err := json.Marshal(httpRoute)
+	if err != nil {
+		slog.Error("Failed to marshal HTTP route", "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(httpRouteJson)},
+		},
+	}, nil, nil
+}
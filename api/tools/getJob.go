@@ -0,0 +1,122 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+var GetJobTool = &mcp.Tool{
+	Name:        "get_job",
+	Description: "Get a job in the Kubernetes cluster",
+}
+
+type GetJobToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the job"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the job"`
+	Summary   bool   `json:"summary,omitempty" jsonschema:"Return a summary of the completion status instead of the full object"`
+}
+
+type JobSummary struct {
+	Name           string `json:"name"`
+	Namespace      string `json:"namespace"`
+	Status         string `json:"status"`
+	Completions    int32  `json:"completions"`
+	Succeeded      int32  `json:"succeeded"`
+	Failed         int32  `json:"failed"`
+	Active         int32  `json:"active"`
+	StartTime      string `json:"startTime,omitempty"`
+	CompletionTime string `json:"completionTime,omitempty"`
+	Duration       string `json:"duration,omitempty"`
+	Message        string `json:"message,omitempty"`
+	ControlledBy   string `json:"controlledBy,omitempty"`
+	Age            string `json:"age"`
+}
+
+// jobStatus returns the overall status of a job and the message of its terminal condition, if any.
+func jobStatus(job *batchv1.Job) (string, string) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return "Complete", condition.Message
+		case batchv1.JobFailed:
+			return "Failed", condition.Message
+		}
+	}
+	if job.Spec.Suspend != nil && *job.Spec.Suspend {
+		return "Suspended", ""
+	}
+	return "Running", ""
+}
+
+func summariseJob(job *batchv1.Job) JobSummary {
+	completions := int32(1)
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+
+	status, message := jobStatus(job)
+	summary := JobSummary{
+		Name:           job.Name,
+		Namespace:      job.Namespace,
+		Status:         status,
+		Completions:    completions,
+		Succeeded:      job.Status.Succeeded,
+		Failed:         job.Status.Failed,
+		Active:         job.Status.Active,
+		StartTime:      formatTime(job.Status.StartTime),
+		CompletionTime: formatTime(job.Status.CompletionTime),
+		Message:        message,
+		Age:            formatAge(job.CreationTimestamp),
+	}
+	if job.Status.StartTime != nil {
+		end := time.Now()
+		if job.Status.CompletionTime != nil {
+			end = job.Status.CompletionTime.Time
+		}
+		summary.Duration = duration.HumanDuration(end.Sub(job.Status.StartTime.Time))
+	}
+	if owner := metav1.GetControllerOf(job); owner != nil {
+		summary.ControlledBy = fmt.Sprintf("%s/%s", owner.Kind, owner.Name)
+	}
+	return summary
+}
+
+func GetJobHandler(ctx context.Context, req *mcp.CallToolRequest, params GetJobToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	job, err := kubernetesApiClient.BatchV1().Jobs(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get job from Kubernetes API", "tool", req.Params.Name, "job", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	var jobJson []byte
+	if params.Summary {
+		jobJson, err = json.Marshal(summariseJob(job))
+	} else {
+		jobJson, err = json.Marshal(job)
+	}
+	if err != nil {
+		slog.Error("Failed to marshal job object", "job", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jobJson)},
+		},
+	}, nil, nil
+}
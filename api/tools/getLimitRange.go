@@ -0,0 +1,43 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var GetLimitRangeTool = &mcp.Tool{
+	Name:        "get_limit_range",
+	Description: "Get a limit range in the Kubernetes cluster",
+}
+
+type GetLimitRangeToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the limit range"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the limit range"`
+}
+
+func GetLimitRangeHandler(ctx context.Context, req *mcp.CallToolRequest, params GetLimitRangeToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	limitRange, err := kubernetesApiClient.CoreV1().LimitRanges(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get limit range from Kubernetes API", "tool", req.Params.Name, "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	limitRangeJson, err := json.Marshal(limitRange)
+	if err != nil {
+		slog.Error("Failed to marshal limit range", "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(limitRangeJson)},
+		},
+	}, nil, nil
+}
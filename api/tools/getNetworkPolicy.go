@@ -0,0 +1,43 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var GetNetworkPolicyTool = &mcp.Tool{
+	Name:        "get_network_policy",
+	Description: "Get a network policy in the Kubernetes cluster",
+}
+
+type GetNetworkPolicyToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the network policy"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the network policy"`
+}
+
+func GetNetworkPolicyHandler(ctx context.Context, req *mcp.CallToolRequest, params GetNetworkPolicyToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	networkPolicy, err := kubernetesApiClient.NetworkingV1().NetworkPolicies(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get network policy from Kubernetes API", "tool", req.Params.Name, "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	networkPolicyJson, err := json.Marshal(networkPolicy)
+	if err != nil {
+		slog.Error("Failed to marshal network policy", "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(networkPolicyJson)},
+		},
+	}, nil, nil
+}
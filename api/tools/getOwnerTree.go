@@ -0,0 +1,192 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// defaultOwnerTreeChildKinds are the kinds searched for owned objects when
+// walking down an ownership tree.
+var defaultOwnerTreeChildKinds = []string{
+	"deployments.apps",
+	"replicasets.apps",
+	"statefulsets.apps",
+	"daemonsets.apps",
+	"jobs.batch",
+	"cronjobs.batch",
+	"pods",
+}
+
+var GetOwnerTreeTool = &mcp.Tool{
+	Name:        "get_owner_tree",
+	Description: "Get the ownership tree of a resource in the Kubernetes cluster, e.g. Deployment → ReplicaSet → Pod. Walks owner references up to the top-level owner and back down to all owned objects",
+}
+
+type GetOwnerTreeToolParams struct {
+	Kind       string   `json:"kind" jsonschema:"The kind or resource name, e.g. Pod, deployments.apps or rollouts.argoproj.io"`
+	Name       string   `json:"name" jsonschema:"The name of the resource"`
+	Namespace  *string  `json:"namespace,omitempty" jsonschema:"The namespace of the resource, required for namespaced resources"`
+	APIVersion *string  `json:"apiVersion,omitempty" jsonschema:"The API version of the kind, e.g. apps/v1, to disambiguate kinds served by multiple groups"`
+	ChildKinds []string `json:"childKinds,omitempty" jsonschema:"Additional kinds to search for owned objects, e.g. rollouts.argoproj.io for custom resources"`
+}
+
+type OwnerTreeNode struct {
+	Kind     string           `json:"kind"`
+	Name     string           `json:"name"`
+	Status   string           `json:"status,omitempty"`
+	Age      string           `json:"age"`
+	Target   bool             `json:"target,omitempty"`
+	Children []*OwnerTreeNode `json:"children,omitempty"`
+}
+
+type GetOwnerTreeResult struct {
+	Namespace string         `json:"namespace,omitempty"`
+	Tree      *OwnerTreeNode `json:"tree"`
+	Errors    []string       `json:"errors,omitempty"`
+}
+
+// indexOwnedObjects lists objects of the given kinds in a namespace, indexed by the UIDs of their owners.
+func indexOwnedObjects(ctx context.Context, namespace string, kinds []string) (map[types.UID][]*unstructured.Unstructured, []string) {
+	index := map[types.UID][]*unstructured.Unstructured{}
+	errors := []string{}
+
+	seen := map[string]bool{}
+	for _, kind := range kinds {
+		mapping, err := resolveKindMapping("", kind)
+		if err != nil {
+			// Kinds which are not served by the cluster (e.g. uninstalled CRDs) are ignored.
+			if !meta.IsNoMatchError(err) {
+				errors = append(errors, fmt.Sprintf("%s: %v", kind, err))
+			}
+			continue
+		}
+		if seen[mapping.Resource.String()] || mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+			continue
+		}
+		if err := checkKindAllowed(mapping); err != nil {
+			errors = append(errors, fmt.Sprintf("%s: %v", kind, err))
+			continue
+		}
+		seen[mapping.Resource.String()] = true
+
+		list, err := resourceInterfaceFor(mapping, namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("%s: %v", mapping.Resource.Resource, err))
+			continue
+		}
+		for i := range list.Items {
+			for _, reference := range list.Items[i].GetOwnerReferences() {
+				index[reference.UID] = append(index[reference.UID], &list.Items[i])
+			}
+		}
+	}
+	return index, errors
+}
+
+// buildOwnerTree builds the tree of objects owned by the given object.
+func buildOwnerTree(object *unstructured.Unstructured, owned map[types.UID][]*unstructured.Unstructured, target types.UID, visited map[types.UID]bool) *OwnerTreeNode {
+	visited[object.GetUID()] = true
+
+	node := &OwnerTreeNode{
+		Kind:   object.GetKind(),
+		Name:   object.GetName(),
+		Status: summariseObjectStatus(object),
+		Age:    formatAge(object.GetCreationTimestamp()),
+		Target: object.GetUID() == target,
+	}
+
+	children := owned[object.GetUID()]
+	slices.SortFunc(children, func(a, b *unstructured.Unstructured) int {
+		return cmp.Or(cmp.Compare(a.GetKind(), b.GetKind()), cmp.Compare(a.GetName(), b.GetName()))
+	})
+	for _, child := range children {
+		if visited[child.GetUID()] {
+			continue
+		}
+		node.Children = append(node.Children, buildOwnerTree(child, owned, target, visited))
+	}
+	return node
+}
+
+func GetOwnerTreeHandler(ctx context.Context, req *mcp.CallToolRequest, params GetOwnerTreeToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+	apiVersion := ""
+	if params.APIVersion != nil {
+		apiVersion = *params.APIVersion
+	}
+
+	mapping, err := resolveKindMapping(apiVersion, params.Kind)
+	if err != nil {
+		slog.Error("Failed to resolve resource kind", "tool", req.Params.Name, "kind", params.Kind, "error", err)
+		return nil, nil, err
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace && namespace == "" {
+		return nil, nil, fmt.Errorf("namespace is required for namespaced kind %s", mapping.GroupVersionKind.Kind)
+	}
+	if err := checkKindAllowed(mapping); err != nil {
+		return nil, nil, err
+	}
+
+	object, err := resourceInterfaceFor(mapping, namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get resource from Kubernetes API", "tool", req.Params.Name, "kind", mapping.GroupVersionKind.Kind, "name", params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	result := GetOwnerTreeResult{
+		Namespace: object.GetNamespace(),
+		Errors:    []string{},
+	}
+
+	// Walk up to the top-level owner.
+	root := object
+	for range maxOwnerDepth {
+		reference := primaryOwner(root)
+		if reference == nil {
+			break
+		}
+		owner, err := getOwner(ctx, reference, object.GetNamespace())
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("owner %s/%s: %v", reference.Kind, reference.Name, err))
+			break
+		}
+		root = owner
+	}
+
+	// Walk down from the top-level owner, owned objects are always in the same namespace.
+	owned := map[types.UID][]*unstructured.Unstructured{}
+	if object.GetNamespace() != "" {
+		var errors []string
+		owned, errors = indexOwnedObjects(ctx, object.GetNamespace(), append(slices.Clone(defaultOwnerTreeChildKinds), params.ChildKinds...))
+		result.Errors = append(result.Errors, errors...)
+	}
+	result.Tree = buildOwnerTree(root, owned, object.GetUID(), map[types.UID]bool{})
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal owner tree", "kind", mapping.GroupVersionKind.Kind, "name", params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
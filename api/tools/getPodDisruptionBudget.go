@@ -0,0 +1,109 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var GetPodDisruptionBudgetTool = &mcp.Tool{
+	Name:        "get_pod_disruption_budget",
+	Description: "Get a pod disruption budget in the Kubernetes cluster",
+}
+
+type GetPodDisruptionBudgetToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the pod disruption budget"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the pod disruption budget"`
+	Summary   bool   `json:"summary,omitempty" jsonschema:"Return a summary of the allowed disruptions and selected pods instead of the full object"`
+}
+
+type PodDisruptionBudgetSummary struct {
+	Name               string   `json:"name"`
+	Namespace          string   `json:"namespace"`
+	MinAvailable       string   `json:"minAvailable,omitempty"`
+	MaxUnavailable     string   `json:"maxUnavailable,omitempty"`
+	CurrentHealthy     int32    `json:"currentHealthy"`
+	DesiredHealthy     int32    `json:"desiredHealthy"`
+	ExpectedPods       int32    `json:"expectedPods"`
+	DisruptionsAllowed int32    `json:"disruptionsAllowed"`
+	Message            string   `json:"message,omitempty"`
+	Pods               []string `json:"pods"`
+	Age                string   `json:"age"`
+}
+
+// summarisePodDisruptionBudget summarises a budget and the pods it selects from the pods in its namespace.
+func summarisePodDisruptionBudget(budget *policyv1.PodDisruptionBudget, pods []corev1.Pod) PodDisruptionBudgetSummary {
+	summary := PodDisruptionBudgetSummary{
+		Name:               budget.Name,
+		Namespace:          budget.Namespace,
+		CurrentHealthy:     budget.Status.CurrentHealthy,
+		DesiredHealthy:     budget.Status.DesiredHealthy,
+		ExpectedPods:       budget.Status.ExpectedPods,
+		DisruptionsAllowed: budget.Status.DisruptionsAllowed,
+		Pods:               []string{},
+		Age:                formatAge(budget.CreationTimestamp),
+	}
+	if budget.Spec.MinAvailable != nil {
+		summary.MinAvailable = budget.Spec.MinAvailable.String()
+	}
+	if budget.Spec.MaxUnavailable != nil {
+		summary.MaxUnavailable = budget.Spec.MaxUnavailable.String()
+	}
+	for _, condition := range budget.Status.Conditions {
+		if condition.Type == policyv1.DisruptionAllowedCondition && condition.Status != metav1.ConditionTrue {
+			summary.Message = condition.Message
+		}
+	}
+
+	// A nil selector selects no pods, an empty selector selects all pods in the namespace.
+	selector, err := metav1.LabelSelectorAsSelector(budget.Spec.Selector)
+	if err != nil {
+		return summary
+	}
+	for _, pod := range pods {
+		if pod.Namespace == budget.Namespace && selector.Matches(labels.Set(pod.Labels)) {
+			summary.Pods = append(summary.Pods, pod.Name)
+		}
+	}
+	return summary
+}
+
+func GetPodDisruptionBudgetHandler(ctx context.Context, req *mcp.CallToolRequest, params GetPodDisruptionBudgetToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	budget, err := kubernetesApiClient.PolicyV1().PodDisruptionBudgets(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get pod disruption budget from Kubernetes API", "tool", req.Params.Name, "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	var budgetJson []byte
+	if params.Summary {
+		var pods *corev1.PodList
+		pods, err = kubernetesApiClient.CoreV1().Pods(params.Namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			slog.Error("Failed to list pods from Kubernetes API", "tool", req.Params.Name, "namespace", params.Namespace, "error", err)
+			return nil, nil, err
+		}
+		budgetJson, err = json.Marshal(summarisePodDisruptionBudget(budget, pods.Items))
+	} else {
+		budgetJson, err = json.Marshal(budget)
+	}
+	if err != nil {
+		slog.Error("Failed to marshal pod disruption budget", "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(budgetJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,83 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var GetReplicaSetTool = &mcp.Tool{
+	Name:        "get_replica_set",
+	Description: "Get a replicaset in the Kubernetes cluster",
+}
+
+type GetReplicaSetToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the replicaset"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the replicaset"`
+	Summary   bool   `json:"summary,omitempty" jsonschema:"Return a summary of the rollout status instead of the full object"`
+}
+
+type ReplicaSetSummary struct {
+	Name              string `json:"name"`
+	Namespace         string `json:"namespace"`
+	Replicas          int32  `json:"replicas"`
+	ReadyReplicas     int32  `json:"readyReplicas"`
+	AvailableReplicas int32  `json:"availableReplicas"`
+	Revision          int64  `json:"revision,omitempty"`
+	ControlledBy      string `json:"controlledBy,omitempty"`
+	Age               string `json:"age"`
+}
+
+func summariseReplicaSet(replicaSet *appsv1.ReplicaSet) ReplicaSetSummary {
+	replicas := int32(1)
+	if replicaSet.Spec.Replicas != nil {
+		replicas = *replicaSet.Spec.Replicas
+	}
+
+	summary := ReplicaSetSummary{
+		Name:              replicaSet.Name,
+		Namespace:         replicaSet.Namespace,
+		Replicas:          replicas,
+		ReadyReplicas:     replicaSet.Status.ReadyReplicas,
+		AvailableReplicas: replicaSet.Status.AvailableReplicas,
+		Revision:          getRevision(replicaSet),
+		Age:               formatAge(replicaSet.CreationTimestamp),
+	}
+	if owner := metav1.GetControllerOf(replicaSet); owner != nil {
+		summary.ControlledBy = fmt.Sprintf("%s/%s", owner.Kind, owner.Name)
+	}
+	return summary
+}
+
+func GetReplicaSetHandler(ctx context.Context, req *mcp.CallToolRequest, params GetReplicaSetToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	replicaSet, err := kubernetesApiClient.AppsV1().ReplicaSets(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get replicaset from Kubernetes API", "tool", req.Params.Name, "replicaset", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	var replicaSetJson []byte
+	if params.Summary {
+		replicaSetJson, err = json.Marshal(summariseReplicaSet(replicaSet))
+	} else {
+		replicaSetJson, err = json.Marshal(replicaSet)
+	}
+	if err != nil {
+		slog.Error("Failed to marshal replicaset object", "replicaset", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(replicaSetJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,43 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var GetResourceQuotaTool = &mcp.Tool{
+	Name:        "get_resource_quota",
+	Description: "Get a resource quota in the Kubernetes cluster",
+}
+
+type GetResourceQuotaToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the resource quota"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the resource quota"`
+}
+
+func GetResourceQuotaHandler(ctx context.Context, req *mcp.CallToolRequest, params GetResourceQuotaToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	resourceQuota, err := kubernetesApiClient.CoreV1().ResourceQuotas(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get resource quota from Kubernetes API", "tool", req.Params.Name, "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	resourceQuotaJson, err := json.Marshal(resourceQuota)
+	if err != nil {
+		slog.Error("Failed to marshal resource quota", "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resourceQuotaJson)},
+		},
+	}, nil, nil
+}
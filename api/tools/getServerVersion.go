@@ -2,8 +2,12 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
+	"log/slog"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"k8s.io/apimachinery/pkg/version"
 )
 
 var GetServerVersionTool = &mcp.Tool{
@@ -11,9 +15,27 @@ var GetServerVersionTool = &mcp.Tool{
 	Description: "Get the Kubernetes API server version details",
 }
 
+// getServerVersion fetches the API server version. Unlike the discovery
+// client's ServerVersion, the request is cancelled with the context.
+func getServerVersion(ctx context.Context) (*version.Info, error) {
+	body, err := kubernetesApiClient.Discovery().RESTClient().Get().AbsPath("/version").Do(ctx).Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	var info version.Info
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
 func GetServerVersionHandler(ctx context.Context, req *mcp.CallToolRequest, params any) (*mcp.CallToolResult, any, error) {
-	version, err := kubernetesApiClient.Discovery().ServerVersion()
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	version, err := getServerVersion(ctx)
 	if err != nil {
+		slog.Error("Failed to get server version from Kubernetes API", "tool", req.Params.Name, "error", err)
 		return nil, nil, err
 	}
 
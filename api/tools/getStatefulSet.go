@@ -0,0 +1,86 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var GetStatefulSetTool = &mcp.Tool{
+	Name:        "get_stateful_set",
+	Description: "Get a statefulset in the Kubernetes cluster",
+}
+
+type GetStatefulSetToolParams struct {
+	Name      string `json:"name" jsonschema:"The name of the statefulset"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the statefulset"`
+	Summary   bool   `json:"summary,omitempty" jsonschema:"Return a summary of the rollout status instead of the full object"`
+}
+
+type StatefulSetSummary struct {
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace"`
+	Replicas        int32  `json:"replicas"`
+	ReadyReplicas   int32  `json:"readyReplicas"`
+	CurrentReplicas int32  `json:"currentReplicas"`
+	UpdatedReplicas int32  `json:"updatedReplicas"`
+	CurrentRevision string `json:"currentRevision,omitempty"`
+	UpdateRevision  string `json:"updateRevision,omitempty"`
+	RolloutComplete bool   `json:"rolloutComplete"`
+	Age             string `json:"age"`
+}
+
+func summariseStatefulSet(statefulSet *appsv1.StatefulSet) StatefulSetSummary {
+	replicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		replicas = *statefulSet.Spec.Replicas
+	}
+
+	status := statefulSet.Status
+	return StatefulSetSummary{
+		Name:            statefulSet.Name,
+		Namespace:       statefulSet.Namespace,
+		Replicas:        replicas,
+		ReadyReplicas:   status.ReadyReplicas,
+		CurrentReplicas: status.CurrentReplicas,
+		UpdatedReplicas: status.UpdatedReplicas,
+		CurrentRevision: status.CurrentRevision,
+		UpdateRevision:  status.UpdateRevision,
+		RolloutComplete: status.ObservedGeneration >= statefulSet.Generation &&
+			status.UpdatedReplicas == replicas &&
+			status.ReadyReplicas == replicas,
+		Age: formatAge(statefulSet.CreationTimestamp),
+	}
+}
+
+func GetStatefulSetHandler(ctx context.Context, req *mcp.CallToolRequest, params GetStatefulSetToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	statefulSet, err := kubernetesApiClient.AppsV1().StatefulSets(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get statefulset from Kubernetes API", "tool", req.Params.Name, "statefulset", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	var statefulSetJson []byte
+	if params.Summary {
+		statefulSetJson, err = json.Marshal(summariseStatefulSet(statefulSet))
+	} else {
+		statefulSetJson, err = json.Marshal(statefulSet)
+	}
+	if err != nil {
+		slog.Error("Failed to marshal statefulset object", "statefulset", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(statefulSetJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,42 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var GetStorageClassTool = &mcp.Tool{
+	Name:        "get_storage_class",
+	Description: "Get a storage class in the Kubernetes cluster",
+}
+
+type GetStorageClassToolParams struct {
+	Name string `json:"name" jsonschema:"The name of the storage class"`
+}
+
+func GetStorageClassHandler(ctx context.Context, req *mcp.CallToolRequest, params GetStorageClassToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	storageClass, err := kubernetesApiClient.StorageV1().StorageClasses().Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get storage class from Kubernetes API", "tool", req.Params.Name, "name", params.Name, "error", err)
+		return nil, nil, err
+	}
+
+	storageClassJson, err := json.Marshal(storageClass)
+	if err != nil {
+		slog.Error("Failed to marshal storage class", "name", params.Name, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(storageClassJson)},
+		},
+	}, nil, nil
+}
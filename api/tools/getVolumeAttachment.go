@@ -0,0 +1,42 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var GetVolumeAttachmentTool = &mcp.Tool{
+	Name:        "get_volume_attachment",
+	Description: "Get a volume attachment in the Kubernetes cluster",
+}
+
+type GetVolumeAttachmentToolParams struct {
+	Name string `json:"name" jsonschema:"The name of the volume attachment"`
+}
+
+func GetVolumeAttachmentHandler(ctx context.Context, req *mcp.CallToolRequest, params GetVolumeAttachmentToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	volumeAttachment, err := kubernetesApiClient.StorageV1().VolumeAttachments().Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get volume attachment from Kubernetes API", "tool", req.Params.Name, "name", params.Name, "error", err)
+		return nil, nil, err
+	}
+
+	volumeAttachmentJson, err := json.Marshal(volumeAttachment)
+	if err != nil {
+		slog.Error("Failed to marshal volume attachment", "name", params.Name, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(volumeAttachmentJson)},
+		},
+	}, nil, nil
+}
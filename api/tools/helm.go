@@ -0,0 +1,63 @@
+package tools
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/cturner8/kube-mcp/helm"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+type HelmReleaseSummary struct {
+	Name         string `json:"name"`
+	Namespace    string `json:"namespace"`
+	Revision     int    `json:"revision"`
+	Status       string `json:"status"`
+	Chart        string `json:"chart"`
+	ChartVersion string `json:"chartVersion"`
+	AppVersion   string `json:"appVersion,omitempty"`
+	Updated      string `json:"updated,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+func summariseHelmRelease(release *helm.Release) HelmReleaseSummary {
+	return HelmReleaseSummary{
+		Name:         release.Name,
+		Namespace:    release.Namespace,
+		Revision:     release.Version,
+		Status:       release.Info.Status,
+		Chart:        release.Chart.Metadata.Name,
+		ChartVersion: release.Chart.Metadata.Version,
+		AppVersion:   release.Chart.Metadata.AppVersion,
+		Updated:      release.Info.LastDeployed,
+		Description:  release.Info.Description,
+	}
+}
+
+// listHelmReleaseSecrets lists the Helm release secrets in a namespace, optionally for a single release.
+// This requires list on secrets, which the chart only grants when rbac.helmReleases is enabled.
+func listHelmReleaseSecrets(ctx context.Context, req *mcp.CallToolRequest, namespace string, name string) ([]corev1.Secret, error) {
+	selector := labels.Set{"owner": "helm"}
+	if name != "" {
+		selector["name"] = name
+	}
+	secrets, err := listAllPages(ctx, req, "Helm release secrets", metav1.ListOptions{
+		LabelSelector: selector.String(),
+		FieldSelector: "type=" + string(helm.ReleaseSecretType),
+	}, kubernetesApiClient.CoreV1().Secrets(namespace).List)
+	if err != nil {
+		return nil, err
+	}
+	return secrets.Items, nil
+}
+
+// helmSecretRevision returns the release revision from the labels of a release secret.
+func helmSecretRevision(secret *corev1.Secret) int {
+	revision, _ := strconv.Atoi(secret.Labels["version"])
+	return revision
+}
@@ -0,0 +1,113 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var ImageInventoryTool = &mcp.Tool{
+	Name:        "image_inventory",
+	Description: "List every container image running in the Kubernetes cluster, with the digests it resolved to, its pull policies and the workloads using it",
+}
+
+type ImageInventoryToolParams struct {
+	Namespaces    []string `json:"namespaces,omitempty" jsonschema:"The namespaces to inventory, defaults to all namespaces"`
+	LabelSelector *string  `json:"labelSelector,omitempty" jsonschema:"A label selector to filter the pods, e.g. app=web"`
+}
+
+type ImageInventoryEntry struct {
+	Image        string   `json:"image"`
+	Tag          string   `json:"tag"`
+	Pinned       bool     `json:"pinned"`
+	Digests      []string `json:"digests"`
+	PullPolicies []string `json:"pullPolicies"`
+	Workloads    []string `json:"workloads"`
+	Containers   int      `json:"containers"`
+}
+
+type ImageInventoryResult struct {
+	Pods   int                   `json:"pods"`
+	Images []ImageInventoryEntry `json:"images"`
+}
+
+// appendUnique appends a value to a slice if it is not already present.
+func appendUnique(values []string, value string) []string {
+	if value == "" || slices.Contains(values, value) {
+		return values
+	}
+	return append(values, value)
+}
+
+func ImageInventoryHandler(ctx context.Context, req *mcp.CallToolRequest, params ImageInventoryToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	labelSelector := ""
+	if params.LabelSelector != nil {
+		labelSelector = *params.LabelSelector
+	}
+
+	pods, err := listPodsInNamespaces(ctx, req, params.Namespaces, labelSelector)
+	if err != nil {
+		slog.Error("Failed to list pods from Kubernetes API", "tool", req.Params.Name, "namespaces", params.Namespaces, "error", err)
+		return nil, nil, err
+	}
+
+	result := ImageInventoryResult{Images: []ImageInventoryEntry{}}
+	images := map[string]*ImageInventoryEntry{}
+	for i := range pods {
+		pod := &pods[i]
+		if isPodTerminated(pod) {
+			continue
+		}
+		result.Pods++
+		workload := fmt.Sprintf("%s/%s", pod.Namespace, podWorkload(pod))
+		containers, statuses := podContainers(pod)
+		for _, container := range containers {
+			entry, ok := images[container.Image]
+			if !ok {
+				tag, pinned := imageTag(container.Image)
+				entry = &ImageInventoryEntry{
+					Image:        container.Image,
+					Tag:          tag,
+					Pinned:       pinned,
+					Digests:      []string{},
+					PullPolicies: []string{},
+					Workloads:    []string{},
+				}
+				images[container.Image] = entry
+			}
+			entry.Containers++
+			entry.PullPolicies = appendUnique(entry.PullPolicies, string(container.ImagePullPolicy))
+			entry.Workloads = appendUnique(entry.Workloads, workload)
+			if status, ok := statuses[container.Name]; ok {
+				entry.Digests = appendUnique(entry.Digests, imageDigest(status.ImageID))
+			}
+		}
+	}
+
+	for _, entry := range images {
+		slices.Sort(entry.Workloads)
+		result.Images = append(result.Images, *entry)
+	}
+	slices.SortFunc(result.Images, func(a, b ImageInventoryEntry) int {
+		return cmp.Compare(a.Image, b.Image)
+	})
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal image inventory", "namespaces", params.Namespaces, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
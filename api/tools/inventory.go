@@ -0,0 +1,94 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// listPodsInNamespaces lists the pods in the given namespaces, or in all namespaces if none are given.
+// Progress is reported per page when listing all namespaces and per namespace otherwise.
+func listPodsInNamespaces(ctx context.Context, req *mcp.CallToolRequest, namespaces []string, labelSelector string) ([]corev1.Pod, error) {
+	options := metav1.ListOptions{LabelSelector: labelSelector}
+	if len(namespaces) == 0 {
+		list, err := listAllPages(ctx, req, "pods", options, kubernetesApiClient.CoreV1().Pods(metav1.NamespaceAll).List)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pods in all namespaces: %w", err)
+		}
+		return list.Items, nil
+	}
+
+	pods := []corev1.Pod{}
+	for i, namespace := range namespaces {
+		list, err := kubernetesApiClient.CoreV1().Pods(namespace).List(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pods in namespace %q: %w", namespace, err)
+		}
+		pods = append(pods, list.Items...)
+		notifyProgress(ctx, req, float64(i+1), float64(len(namespaces)), fmt.Sprintf("Listed %d pods in namespace %s", len(list.Items), namespace))
+	}
+	return pods, nil
+}
+
+// podWorkload names the workload which manages a pod, e.g. Deployment/web, without
+// further API calls. ReplicaSets created by a Deployment are resolved to the
+// Deployment from their pod template hash, and unmanaged pods are named as pods.
+func podWorkload(pod *corev1.Pod) string {
+	owner := primaryOwner(pod)
+	if owner == nil {
+		return "Pod/" + pod.Name
+	}
+	if hash := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; owner.Kind == "ReplicaSet" && hash != "" {
+		if deployment, found := strings.CutSuffix(owner.Name, "-"+hash); found {
+			return "Deployment/" + deployment
+		}
+	}
+	return owner.Kind + "/" + owner.Name
+}
+
+// podContainers returns the init, app and ephemeral containers of a pod as
+// plain containers, with the statuses reported for them keyed by name.
+func podContainers(pod *corev1.Pod) ([]corev1.Container, map[string]corev1.ContainerStatus) {
+	containers := append([]corev1.Container{}, pod.Spec.InitContainers...)
+	containers = append(containers, pod.Spec.Containers...)
+	for _, ephemeral := range pod.Spec.EphemeralContainers {
+		containers = append(containers, corev1.Container(ephemeral.EphemeralContainerCommon))
+	}
+
+	statuses := map[string]corev1.ContainerStatus{}
+	for _, list := range [][]corev1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses, pod.Status.EphemeralContainerStatuses} {
+		for _, status := range list {
+			statuses[status.Name] = status
+		}
+	}
+	return containers, statuses
+}
+
+// imageDigest extracts the digest from a container status image ID, e.g.
+// docker-pullable://nginx@sha256:abc or sha256:abc.
+func imageDigest(imageID string) string {
+	if _, digest, found := strings.Cut(imageID, "@"); found {
+		return digest
+	}
+	return strings.TrimPrefix(imageID, "docker://")
+}
+
+// imageTag returns the tag of an image reference, defaulting to latest when no
+// tag is given, and whether the reference is pinned to a digest.
+func imageTag(image string) (string, bool) {
+	image, digest, pinned := strings.Cut(image, "@")
+	if pinned && digest == "" {
+		pinned = false
+	}
+	lastSlash := strings.LastIndex(image, "/")
+	if colon := strings.LastIndex(image, ":"); colon > lastSlash {
+		return image[colon+1:], pinned
+	}
+	return "latest", pinned
+}
@@ -0,0 +1,73 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+var NamespacedResourceTemplate = &mcp.ResourceTemplate{
+	Name:        "namespaced_resource",
+	URITemplate: "kube://namespaces/{namespace}/{kind}/{name}",
+	Description: "A namespaced resource in the Kubernetes cluster, described as by describe_resource. The kind may be a kind or resource name, e.g. Pod, deployments.apps or svc",
+	MIMEType:    "application/json",
+}
+
+var ClusterResourceTemplate = &mcp.ResourceTemplate{
+	Name:        "cluster_resource",
+	URITemplate: "kube://cluster/{kind}/{name}",
+	Description: "A cluster scoped resource in the Kubernetes cluster, described as by describe_resource. The kind may be a kind or resource name, e.g. Node or storageclasses",
+	MIMEType:    "application/json",
+}
+
+// parseKubeResourceURI splits a kube:// resource URI into its namespace, kind and name.
+func parseKubeResourceURI(uri string) (string, string, string, error) {
+	path, found := strings.CutPrefix(uri, "kube://")
+	if !found {
+		return "", "", "", fmt.Errorf("resource URI %q is not a kube:// URI", uri)
+	}
+	parts := strings.Split(path, "/")
+	switch {
+	case len(parts) == 4 && parts[0] == "namespaces":
+		return parts[1], parts[2], parts[3], nil
+	case len(parts) == 3 && parts[0] == "cluster":
+		return "", parts[1], parts[2], nil
+	}
+	return "", "", "", fmt.Errorf("resource URI %q does not match %s or %s", uri, NamespacedResourceTemplate.URITemplate, ClusterResourceTemplate.URITemplate)
+}
+
+func ReadKubeResourceHandler(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	slog.Debug("Resource read", "uri", req.Params.URI)
+
+	namespace, kind, name, err := parseKubeResourceURI(req.Params.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := describeResource(ctx, "", kind, name, namespace)
+	if apierrors.IsNotFound(err) {
+		return nil, mcp.ResourceNotFoundError(req.Params.URI)
+	}
+	if err != nil {
+		slog.Error("Failed to describe resource", "uri", req.Params.URI, "error", err)
+		return nil, err
+	}
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal resource description", "uri", req.Params.URI, "error", err)
+		return nil, err
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{URI: req.Params.URI, MIMEType: "application/json", Text: string(resultJson)},
+		},
+	}, nil
+}
@@ -0,0 +1,135 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// discoveryRefreshInterval is how often list_api_resources refreshes the cached
+// discovery information, so that newly installed CRDs are listed without
+// discarding the cache used by completion and the REST mapper on every call.
+const discoveryRefreshInterval = 5 * time.Minute
+
+var (
+	discoveryRefreshMutex sync.Mutex
+	discoveryRefreshed    = time.Now()
+)
+
+// refreshDiscovery invalidates the cached discovery information if it is older
+// than discoveryRefreshInterval.
+func refreshDiscovery() {
+	discoveryRefreshMutex.Lock()
+	defer discoveryRefreshMutex.Unlock()
+	if time.Since(discoveryRefreshed) < discoveryRefreshInterval {
+		return
+	}
+	kubernetesCachedDiscoveryClient.Invalidate()
+	discoveryRefreshed = time.Now()
+}
+
+var ListAPIResourcesTool = &mcp.Tool{
+	Name:        "list_api_resources",
+	Description: "List the API resources served by the Kubernetes cluster, including custom resources, similar to kubectl api-resources",
+}
+
+type ListAPIResourcesToolParams struct {
+	APIGroup   *string `json:"apiGroup,omitempty" jsonschema:"Only list resources in this API group, use an empty string for the core group"`
+	Namespaced *bool   `json:"namespaced,omitempty" jsonschema:"Only list namespaced (true) or cluster scoped (false) resources"`
+	Verb       *string `json:"verb,omitempty" jsonschema:"Only list resources supporting this verb, e.g. list or patch"`
+}
+
+type APIResourceSummary struct {
+	Name       string   `json:"name"`
+	ShortNames []string `json:"shortNames,omitempty"`
+	Group      string   `json:"group"`
+	Version    string   `json:"version"`
+	Kind       string   `json:"kind"`
+	Namespaced bool     `json:"namespaced"`
+	Verbs      []string `json:"verbs"`
+}
+
+type ListAPIResourcesResult struct {
+	Resources []APIResourceSummary `json:"resources"`
+	Errors    []string             `json:"errors,omitempty"`
+}
+
+func ListAPIResourcesHandler(ctx context.Context, req *mcp.CallToolRequest, params ListAPIResourcesToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	refreshDiscovery()
+
+	result := ListAPIResourcesResult{Resources: []APIResourceSummary{}, Errors: []string{}}
+	resourceLists, err := kubernetesCachedDiscoveryClient.ServerPreferredResources()
+	if err != nil {
+		// Groups which failed discovery (e.g. an unavailable aggregated API) are
+		// reported, the other groups are still listed.
+		groupErr, ok := err.(*discovery.ErrGroupDiscoveryFailed)
+		if !ok {
+			slog.Error("Failed to discover API resources from Kubernetes API", "tool", req.Params.Name, "error", err)
+			return nil, nil, err
+		}
+		for groupVersion, groupVersionErr := range groupErr.Groups {
+			result.Errors = append(result.Errors, groupVersion.String()+": "+groupVersionErr.Error())
+		}
+		slices.Sort(result.Errors)
+	}
+
+	for _, resourceList := range resourceLists {
+		groupVersion, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			result.Errors = append(result.Errors, err.Error())
+			continue
+		}
+		if params.APIGroup != nil && *params.APIGroup != groupVersion.Group {
+			continue
+		}
+
+		for _, resource := range resourceList.APIResources {
+			// Skip subresources, e.g. pods/log.
+			if strings.Contains(resource.Name, "/") {
+				continue
+			}
+			if params.Namespaced != nil && *params.Namespaced != resource.Namespaced {
+				continue
+			}
+			if params.Verb != nil && !slices.Contains(resource.Verbs, *params.Verb) {
+				continue
+			}
+			result.Resources = append(result.Resources, APIResourceSummary{
+				Name:       resource.Name,
+				ShortNames: resource.ShortNames,
+				Group:      groupVersion.Group,
+				Version:    groupVersion.Version,
+				Kind:       resource.Kind,
+				Namespaced: resource.Namespaced,
+				Verbs:      resource.Verbs,
+			})
+		}
+	}
+	slices.SortFunc(result.Resources, func(a, b APIResourceSummary) int {
+		return cmp.Or(cmp.Compare(a.Group, b.Group), cmp.Compare(a.Name, b.Name))
+	})
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal API resources", "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,38 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListClusterRoleBindingsTool = &mcp.Tool{
+	Name:        "list_cluster_role_bindings",
+	Description: "List the RBAC cluster role bindings in the Kubernetes cluster",
+}
+
+func ListClusterRoleBindingsHandler(ctx context.Context, req *mcp.CallToolRequest, params any) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	clusterRoleBindings, err := listAllPages(ctx, req, "cluster role bindings", metav1.ListOptions{}, kubernetesApiClient.RbacV1().ClusterRoleBindings().List)
+	if err != nil {
+		slog.Error("Failed to list cluster role bindings from Kubernetes API", "tool", req.Params.Name, "error", err)
+		return nil, nil, err
+	}
+
+	clusterRoleBindingsJson, err := json.Marshal(clusterRoleBindings)
+	if err != nil {
+		slog.Error("Failed to marshal cluster role bindings list", "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(clusterRoleBindingsJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,38 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListClusterRolesTool = &mcp.Tool{
+	Name:        "list_cluster_roles",
+	Description: "List the RBAC cluster roles in the Kubernetes cluster",
+}
+
+func ListClusterRolesHandler(ctx context.Context, req *mcp.CallToolRequest, params any) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	clusterRoles, err := listAllPages(ctx, req, "cluster roles", metav1.ListOptions{}, kubernetesApiClient.RbacV1().ClusterRoles().List)
+	if err != nil {
+		slog.Error("Failed to list cluster roles from Kubernetes API", "tool", req.Params.Name, "error", err)
+		return nil, nil, err
+	}
+
+	clusterRolesJson, err := json.Marshal(clusterRoles)
+	if err != nil {
+		slog.Error("Failed to marshal cluster roles list", "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(clusterRolesJson)},
+		},
+	}, nil, nil
+}
@@ -27,7 +27,7 @@ func ListConfigMapsHandler(ctx context.Context, req *mcp.CallToolRequest, params
 		namespace = *params.Namespace
 	}
 
-	configMaps, err := kubernetesApiClient.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{})
+	configMaps, err := listAllPages(ctx, req, "config maps", metav1.ListOptions{}, kubernetesApiClient.CoreV1().ConfigMaps(namespace).List)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,57 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListCronJobsTool = &mcp.Tool{
+	Name:        "list_cron_jobs",
+	Description: "List the cronjobs in the Kubernetes cluster",
+}
+
+type ListCronJobsToolParams struct {
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the cronjobs"`
+	Summary   bool    `json:"summary,omitempty" jsonschema:"Return a summary of each cronjob's schedule status instead of the full objects"`
+}
+
+func ListCronJobsHandler(ctx context.Context, req *mcp.CallToolRequest, params ListCronJobsToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	cronJobs, err := listAllPages(ctx, req, "cron jobs", metav1.ListOptions{}, kubernetesApiClient.BatchV1().CronJobs(namespace).List)
+	if err != nil {
+		slog.Error("Failed to list cronjobs from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	var cronJobsJson []byte
+	if params.Summary {
+		summaries := []CronJobSummary{}
+		for i := range cronJobs.Items {
+			summaries = append(summaries, summariseCronJob(&cronJobs.Items[i]))
+		}
+		cronJobsJson, err = json.Marshal(summaries)
+	} else {
+		cronJobsJson, err = json.Marshal(cronJobs)
+	}
+	if err != nil {
+		slog.Error("Failed to marshal cronjobs list", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(cronJobsJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,38 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListCSIDriversTool = &mcp.Tool{
+	Name:        "list_csi_drivers",
+	Description: "List the CSI drivers in the Kubernetes cluster",
+}
+
+func ListCSIDriversHandler(ctx context.Context, req *mcp.CallToolRequest, params any) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	csiDrivers, err := listAllPages(ctx, req, "CSI drivers", metav1.ListOptions{}, kubernetesApiClient.StorageV1().CSIDrivers().List)
+	if err != nil {
+		slog.Error("Failed to list CSI drivers from Kubernetes API", "tool", req.Params.Name, "error", err)
+		return nil, nil, err
+	}
+
+	csiDriversJson, err := json.Marshal(csiDrivers)
+	if err != nil {
+		slog.Error("Failed to marshal CSI drivers list", "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(csiDriversJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,57 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListDaemonSetsTool = &mcp.Tool{
+	Name:        "list_daemon_sets",
+	Description: "List the daemonsets in the Kubernetes cluster",
+}
+
+type ListDaemonSetsToolParams struct {
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the daemonsets"`
+	Summary   bool    `json:"summary,omitempty" jsonschema:"Return a summary of each daemonset's rollout status instead of the full objects"`
+}
+
+func ListDaemonSetsHandler(ctx context.Context, req *mcp.CallToolRequest, params ListDaemonSetsToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	daemonSets, err := listAllPages(ctx, req, "daemon sets", metav1.ListOptions{}, kubernetesApiClient.AppsV1().DaemonSets(namespace).List)
+	if err != nil {
+		slog.Error("Failed to list daemonsets from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	var daemonSetsJson []byte
+	if params.Summary {
+		summaries := []DaemonSetSummary{}
+		for i := range daemonSets.Items {
+			summaries = append(summaries, summariseDaemonSet(&daemonSets.Items[i]))
+		}
+		daemonSetsJson, err = json.Marshal(summaries)
+	} else {
+		daemonSetsJson, err = json.Marshal(daemonSets)
+	}
+	if err != nil {
+		slog.Error("Failed to marshal daemonsets list", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(daemonSetsJson)},
+		},
+	}, nil, nil
+}
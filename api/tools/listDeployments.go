@@ -27,7 +27,7 @@ func ListDeploymentsHandler(ctx context.Context, req *mcp.CallToolRequest, param
 		namespace = *params.Namespace
 	}
 
-	deployments, err := kubernetesApiClient.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	deployments, err := listAllPages(ctx, req, "deployments", metav1.ListOptions{}, kubernetesApiClient.AppsV1().Deployments(namespace).List)
 	if err != nil {
 		return nil, nil, err
 	}
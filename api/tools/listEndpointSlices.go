@@ -0,0 +1,55 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListEndpointSlicesTool = &mcp.Tool{
+	Name:        "list_endpoint_slices",
+	Description: "List the endpoint slices in the Kubernetes cluster",
+}
+
+type ListEndpointSlicesToolParams struct {
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the endpoint slices"`
+	Service   *string `json:"service,omitempty" jsonschema:"Only list the endpoint slices of this service"`
+}
+
+func ListEndpointSlicesHandler(ctx context.Context, req *mcp.CallToolRequest, params ListEndpointSlicesToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	listOptions := metav1.ListOptions{}
+	if params.Service != nil {
+		listOptions.LabelSelector = fmt.Sprintf("%s=%s", discoveryv1.LabelServiceName, *params.Service)
+	}
+
+	endpointSlices, err := listAllPages(ctx, req, "endpoint slices", listOptions, kubernetesApiClient.DiscoveryV1().EndpointSlices(namespace).List)
+	if err != nil {
+		slog.Error("Failed to list endpoint slices from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	endpointSlicesJson, err := json.Marshal(endpointSlices)
+	if err != nil {
+		slog.Error("Failed to marshal endpoint slices list", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(endpointSlicesJson)},
+		},
+	}, nil, nil
+}
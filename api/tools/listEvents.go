@@ -3,20 +3,84 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log/slog"
+	"slices"
+	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
+	eventsv1 "k8s.io/api/events/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 )
 
+// defaultEventLimit is the number of events returned when no limit is given.
+const defaultEventLimit = 100
+
 var ListEventsTool = &mcp.Tool{
 	Name:        "list_events",
-	Description: "List the events in the Kubernetes cluster",
+	Description: "List the events in the Kubernetes cluster, newest first, with repeated events collapsed into one with a count. Filter by type, reason, involved object and age",
 }
 
 type ListEventsToolParams struct {
-	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the events"`
+	Namespace          *string `json:"namespace,omitempty" jsonschema:"The namespace of the events"`
+	Type               *string `json:"type,omitempty" jsonschema:"Only return events of this type: Normal or Warning"`
+	Reason             *string `json:"reason,omitempty" jsonschema:"Only return events with this reason, e.g. FailedScheduling"`
+	InvolvedObjectKind *string `json:"involvedObjectKind,omitempty" jsonschema:"Only return events for objects of this kind, e.g. Pod"`
+	InvolvedObjectName *string `json:"involvedObjectName,omitempty" jsonschema:"Only return events for objects with this name"`
+	Since              *string `json:"since,omitempty" jsonschema:"Only return events last seen within this duration, e.g. 30m or 2h"`
+	Limit              *int    `json:"limit,omitempty" jsonschema:"The maximum number of events to return, defaults to 100"`
+}
+
+type ListEventsEntry struct {
+	Namespace string `json:"namespace,omitempty"`
+	Object    string `json:"object"`
+	EventSummary
+
+	firstSeen time.Time
+	lastSeen  time.Time
+}
+
+type ListEventsResult struct {
+	// Total is the number of events after collapsing repeats, before the limit is applied.
+	Total  int               `json:"total"`
+	Events []ListEventsEntry `json:"events"`
+}
+
+// collapseEvents merges events for the same object with the same type, reason
+// and message, which controllers may record as separate events.
+func collapseEvents(events []eventsv1.Event) []ListEventsEntry {
+	entries := []ListEventsEntry{}
+	indexes := map[string]int{}
+	for i := range events {
+		event := &events[i]
+		entry := ListEventsEntry{
+			Namespace:    event.Namespace,
+			Object:       fmt.Sprintf("%s/%s", event.Regarding.Kind, event.Regarding.Name),
+			EventSummary: summariseEvent(event),
+			firstSeen:    eventFirstSeen(event),
+			lastSeen:     eventLastSeen(event),
+		}
+
+		key := fmt.Sprintf("%s/%s/%s/%s/%s", entry.Namespace, entry.Object, entry.Type, entry.Reason, entry.Message)
+		index, ok := indexes[key]
+		if !ok {
+			indexes[key] = len(entries)
+			entries = append(entries, entry)
+			continue
+		}
+
+		existing := &entries[index]
+		existing.Count += entry.Count
+		if !entry.firstSeen.IsZero() && (existing.firstSeen.IsZero() || entry.firstSeen.Before(existing.firstSeen)) {
+			existing.firstSeen, existing.FirstSeen = entry.firstSeen, entry.FirstSeen
+		}
+		if entry.lastSeen.After(existing.lastSeen) {
+			existing.lastSeen, existing.LastSeen = entry.lastSeen, entry.LastSeen
+		}
+	}
+	return entries
 }
 
 func ListEventsHandler(ctx context.Context, req *mcp.CallToolRequest, params ListEventsToolParams) (*mcp.CallToolResult, any, error) {
@@ -26,14 +90,56 @@ func ListEventsHandler(ctx context.Context, req *mcp.CallToolRequest, params Lis
 	if params.Namespace != nil {
 		namespace = *params.Namespace
 	}
+	limit := defaultEventLimit
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+	var cutoff time.Time
+	if params.Since != nil && *params.Since != "" {
+		since, err := time.ParseDuration(*params.Since)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid since duration %q: %w", *params.Since, err)
+		}
+		cutoff = time.Now().Add(-since)
+	}
+
+	// Filters are applied by the API server where possible.
+	selectors := []fields.Selector{}
+	for field, value := range map[string]*string{
+		"type":           params.Type,
+		"reason":         params.Reason,
+		"regarding.kind": params.InvolvedObjectKind,
+		"regarding.name": params.InvolvedObjectName,
+	} {
+		if value != nil && *value != "" {
+			selectors = append(selectors, fields.OneTermEqualSelector(field, *value))
+		}
+	}
 
-	events, err := kubernetesApiClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
+	events, err := listAllPages(ctx, req, "events", metav1.ListOptions{
+		FieldSelector: fields.AndSelectors(selectors...).String(),
+	}, kubernetesApiClient.EventsV1().Events(namespace).List)
 	if err != nil {
 		slog.Error("Failed to list events from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
 		return nil, nil, err
 	}
 
-	eventsJson, err := json.Marshal(events)
+	entries := collapseEvents(events.Items)
+	if !cutoff.IsZero() {
+		entries = slices.DeleteFunc(entries, func(entry ListEventsEntry) bool {
+			return entry.lastSeen.Before(cutoff)
+		})
+	}
+	slices.SortStableFunc(entries, func(a, b ListEventsEntry) int {
+		return b.lastSeen.Compare(a.lastSeen)
+	})
+
+	result := ListEventsResult{Total: len(entries), Events: entries}
+	if len(entries) > limit {
+		result.Events = entries[:limit]
+	}
+
+	resultJson, err := json.Marshal(result)
 	if err != nil {
 		slog.Error("Failed to marshal events list", "namespace", namespace, "error", err)
 		return nil, nil, err
@@ -41,7 +147,7 @@ func ListEventsHandler(ctx context.Context, req *mcp.CallToolRequest, params Lis
 
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
-			&mcp.TextContent{Text: string(eventsJson)},
+			&mcp.TextContent{Text: string(resultJson)},
 		},
 	}, nil, nil
 }
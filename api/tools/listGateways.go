@@ -0,0 +1,45 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var ListGatewaysTool = &mcp.Tool{
+	Name:        "list_gateways",
+	Description: "List the Gateway API gateways in the Kubernetes cluster",
+}
+
+type ListGatewaysToolParams struct {
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the gateways"`
+}
+
+func ListGatewaysHandler(ctx context.Context, req *mcp.CallToolRequest, params ListGatewaysToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	gateways, err := listGatewayAPIResources(ctx, req, gatewayAPIGateways, namespace)
+	if err != nil {
+		slog.Error("Failed to list gateways from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	gatewaysJson, err := json.Marshal(gateways)
+	if err != nil {
+		slog.Error("Failed to marshal gateways list", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(gatewaysJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,45 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var ListGRPCRoutesTool = &mcp.Tool{
+	Name:        "list_grpc_routes",
+	Description: "List the Gateway API gRPC routes in the Kubernetes cluster",
+}
+
+type ListGRPCRoutesToolParams struct {
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the gRPC routes"`
+}
+
+func ListGRPCRoutesHandler(ctx context.Context, req *mcp.CallToolRequest, params ListGRPCRoutesToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	grpcRoutes, err := listGatewayAPIResources(ctx, req, gatewayAPIGRPCRoutes, namespace)
+	if err != nil {
+		slog.Error("Failed to list gRPC routes from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	grpcRoutesJson, err := json.Marshal(grpcRoutes)
+	if err != nil {
+		slog.Error("Failed to marshal gRPC routes list", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(grpcRoutesJson)},
+		},
+	}, nil, nil
+}
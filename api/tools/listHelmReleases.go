@@ -0,0 +1,77 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/cturner8/kube-mcp/helm"
+)
+
+var ListHelmReleasesTool = &mcp.Tool{
+	Name:        "list_helm_releases",
+	Description: "List the Helm releases in the Kubernetes cluster, decoded from Helm's release secrets. Returns the latest revision of each release with its chart, version and status. Requires list permission on secrets, which RBAC cannot limit to Helm's release secrets, so the server can then read every secret in the namespaces it lists",
+}
+
+type ListHelmReleasesToolParams struct {
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the Helm releases"`
+}
+
+type ListHelmReleasesResult struct {
+	Releases []HelmReleaseSummary `json:"releases"`
+	Errors   []string             `json:"errors,omitempty"`
+}
+
+func ListHelmReleasesHandler(ctx context.Context, req *mcp.CallToolRequest, params ListHelmReleasesToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	secrets, err := listHelmReleaseSecrets(ctx, req, namespace, "")
+	if err != nil {
+		slog.Error("Failed to list Helm release secrets from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	// Only the latest revision of each release is decoded.
+	latest := map[string]int{}
+	for i := range secrets {
+		key := secrets[i].Namespace + "/" + secrets[i].Labels["name"]
+		if current, ok := latest[key]; !ok || helmSecretRevision(&secrets[i]) > helmSecretRevision(&secrets[current]) {
+			latest[key] = i
+		}
+	}
+
+	result := ListHelmReleasesResult{Releases: []HelmReleaseSummary{}, Errors: []string{}}
+	for _, i := range latest {
+		release, err := helm.DecodeRelease(&secrets[i])
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s/%s: %v", secrets[i].Namespace, secrets[i].Name, err))
+			continue
+		}
+		result.Releases = append(result.Releases, summariseHelmRelease(release))
+	}
+	slices.SortFunc(result.Releases, func(a, b HelmReleaseSummary) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal Helm releases list", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,57 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListHorizontalPodAutoscalersTool = &mcp.Tool{
+	Name:        "list_horizontal_pod_autoscalers",
+	Description: "List the horizontal pod autoscalers in the Kubernetes cluster",
+}
+
+type ListHorizontalPodAutoscalersToolParams struct {
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the horizontal pod autoscalers"`
+	Summary   bool    `json:"summary,omitempty" jsonschema:"Return a summary of each horizontal pod autoscaler's scaling status, metrics and conditions instead of the full objects"`
+}
+
+func ListHorizontalPodAutoscalersHandler(ctx context.Context, req *mcp.CallToolRequest, params ListHorizontalPodAutoscalersToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	hpas, err := listAllPages(ctx, req, "horizontal pod autoscalers", metav1.ListOptions{}, kubernetesApiClient.AutoscalingV2().HorizontalPodAutoscalers(namespace).List)
+	if err != nil {
+		slog.Error("Failed to list horizontal pod autoscalers from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	var hpasJson []byte
+	if params.Summary {
+		summaries := []HorizontalPodAutoscalerSummary{}
+		for i := range hpas.Items {
+			summaries = append(summaries, summariseHorizontalPodAutoscaler(&hpas.Items[i]))
+		}
+		hpasJson, err = json.Marshal(summaries)
+	} else {
+		hpasJson, err = json.Marshal(hpas)
+	}
+	if err != nil {
+		slog.Error("Failed to marshal horizontal pod autoscalers list", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(hpasJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,45 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var ListHTTPRoutesTool = &mcp.Tool{
+	Name:        "list_http_routes",
+	Description: "List the Gateway API HTTP routes in the Kubernetes cluster",
+}
+
+type ListHTTPRoutesToolParams struct {
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the HTTP routes"`
+}
+
+func ListHTTPRoutesHandler(ctx context.Context, req *mcp.CallToolRequest, params ListHTTPRoutesToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	httpRoutes, err := listGatewayAPIResources(ctx, req, gatewayAPIHTTPRoutes, namespace)
+	if err != nil {
+		slog.Error("Failed to list HTTP routes from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	httpRoutesJson, err := json.Marshal(httpRoutes)
+	if err != nil {
+		slog.Error("Failed to marshal HTTP routes list", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(httpRoutesJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,57 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListJobsTool = &mcp.Tool{
+	Name:        "list_jobs",
+	Description: "List the jobs in the Kubernetes cluster",
+}
+
+type ListJobsToolParams struct {
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the jobs"`
+	Summary   bool    `json:"summary,omitempty" jsonschema:"Return a summary of each job's completion status instead of the full objects"`
+}
+
+func ListJobsHandler(ctx context.Context, req *mcp.CallToolRequest, params ListJobsToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	jobs, err := listAllPages(ctx, req, "jobs", metav1.ListOptions{}, kubernetesApiClient.BatchV1().Jobs(namespace).List)
+	if err != nil {
+		slog.Error("Failed to list jobs from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	var jobsJson []byte
+	if params.Summary {
+		summaries := []JobSummary{}
+		for i := range jobs.Items {
+			summaries = append(summaries, summariseJob(&jobs.Items[i]))
+		}
+		jobsJson, err = json.Marshal(summaries)
+	} else {
+		jobsJson, err = json.Marshal(jobs)
+	}
+	if err != nil {
+		slog.Error("Failed to marshal jobs list", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jobsJson)},
+		},
+	}, nil, nil
+}
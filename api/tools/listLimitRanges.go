@@ -0,0 +1,47 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListLimitRangesTool = &mcp.Tool{
+	Name:        "list_limit_ranges",
+	Description: "List the limit ranges in the Kubernetes cluster",
+}
+
+type ListLimitRangesToolParams struct {
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the limit ranges"`
+}
+
+func ListLimitRangesHandler(ctx context.Context, req *mcp.CallToolRequest, params ListLimitRangesToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	limitRanges, err := listAllPages(ctx, req, "limit ranges", metav1.ListOptions{}, kubernetesApiClient.CoreV1().LimitRanges(namespace).List)
+	if err != nil {
+		slog.Error("Failed to list limit ranges from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	limitRangesJson, err := json.Marshal(limitRanges)
+	if err != nil {
+		slog.Error("Failed to marshal limit ranges list", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(limitRangesJson)},
+		},
+	}, nil, nil
+}
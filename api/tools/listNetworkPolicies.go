@@ -0,0 +1,47 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListNetworkPoliciesTool = &mcp.Tool{
+	Name:        "list_network_policies",
+	Description: "List the network policies in the Kubernetes cluster",
+}
+
+type ListNetworkPoliciesToolParams struct {
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the network policies"`
+}
+
+func ListNetworkPoliciesHandler(ctx context.Context, req *mcp.CallToolRequest, params ListNetworkPoliciesToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	networkPolicies, err := listAllPages(ctx, req, "network policies", metav1.ListOptions{}, kubernetesApiClient.NetworkingV1().NetworkPolicies(namespace).List)
+	if err != nil {
+		slog.Error("Failed to list network policies from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	networkPoliciesJson, err := json.Marshal(networkPolicies)
+	if err != nil {
+		slog.Error("Failed to marshal network policies list", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(networkPoliciesJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,64 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListPodDisruptionBudgetsTool = &mcp.Tool{
+	Name:        "list_pod_disruption_budgets",
+	Description: "List the pod disruption budgets in the Kubernetes cluster",
+}
+
+type ListPodDisruptionBudgetsToolParams struct {
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the pod disruption budgets"`
+	Summary   bool    `json:"summary,omitempty" jsonschema:"Return a summary of each pod disruption budget's allowed disruptions and selected pods instead of the full objects"`
+}
+
+func ListPodDisruptionBudgetsHandler(ctx context.Context, req *mcp.CallToolRequest, params ListPodDisruptionBudgetsToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	budgets, err := listAllPages(ctx, req, "pod disruption budgets", metav1.ListOptions{}, kubernetesApiClient.PolicyV1().PodDisruptionBudgets(namespace).List)
+	if err != nil {
+		slog.Error("Failed to list pod disruption budgets from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	var budgetsJson []byte
+	if params.Summary {
+		var pods *corev1.PodList
+		pods, err = listAllPages(ctx, req, "pods", metav1.ListOptions{}, kubernetesApiClient.CoreV1().Pods(namespace).List)
+		if err != nil {
+			slog.Error("Failed to list pods from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+			return nil, nil, err
+		}
+		summaries := []PodDisruptionBudgetSummary{}
+		for i := range budgets.Items {
+			summaries = append(summaries, summarisePodDisruptionBudget(&budgets.Items[i], pods.Items))
+		}
+		budgetsJson, err = json.Marshal(summaries)
+	} else {
+		budgetsJson, err = json.Marshal(budgets)
+	}
+	if err != nil {
+		slog.Error("Failed to marshal pod disruption budgets list", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(budgetsJson)},
+		},
+	}, nil, nil
+}
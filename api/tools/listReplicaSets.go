@@ -0,0 +1,57 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListReplicaSetsTool = &mcp.Tool{
+	Name:        "list_replica_sets",
+	Description: "List the replicasets in the Kubernetes cluster",
+}
+
+type ListReplicaSetsToolParams struct {
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the replicasets"`
+	Summary   bool    `json:"summary,omitempty" jsonschema:"Return a summary of each replicaset's rollout status instead of the full objects"`
+}
+
+func ListReplicaSetsHandler(ctx context.Context, req *mcp.CallToolRequest, params ListReplicaSetsToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	replicaSets, err := listAllPages(ctx, req, "replica sets", metav1.ListOptions{}, kubernetesApiClient.AppsV1().ReplicaSets(namespace).List)
+	if err != nil {
+		slog.Error("Failed to list replicasets from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	var replicaSetsJson []byte
+	if params.Summary {
+		summaries := []ReplicaSetSummary{}
+		for i := range replicaSets.Items {
+			summaries = append(summaries, summariseReplicaSet(&replicaSets.Items[i]))
+		}
+		replicaSetsJson, err = json.Marshal(summaries)
+	} else {
+		replicaSetsJson, err = json.Marshal(replicaSets)
+	}
+	if err != nil {
+		slog.Error("Failed to marshal replicasets list", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(replicaSetsJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,47 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListResourceQuotasTool = &mcp.Tool{
+	Name:        "list_resource_quotas",
+	Description: "List the resource quotas in the Kubernetes cluster",
+}
+
+type ListResourceQuotasToolParams struct {
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the resource quotas"`
+}
+
+func ListResourceQuotasHandler(ctx context.Context, req *mcp.CallToolRequest, params ListResourceQuotasToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	resourceQuotas, err := listAllPages(ctx, req, "resource quotas", metav1.ListOptions{}, kubernetesApiClient.CoreV1().ResourceQuotas(namespace).List)
+	if err != nil {
+		slog.Error("Failed to list resource quotas from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	resourceQuotasJson, err := json.Marshal(resourceQuotas)
+	if err != nil {
+		slog.Error("Failed to marshal resource quotas list", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resourceQuotasJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,47 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListRoleBindingsTool = &mcp.Tool{
+	Name:        "list_role_bindings",
+	Description: "List the RBAC role bindings in the Kubernetes cluster",
+}
+
+type ListRoleBindingsToolParams struct {
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the role bindings"`
+}
+
+func ListRoleBindingsHandler(ctx context.Context, req *mcp.CallToolRequest, params ListRoleBindingsToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	roleBindings, err := listAllPages(ctx, req, "role bindings", metav1.ListOptions{}, kubernetesApiClient.RbacV1().RoleBindings(namespace).List)
+	if err != nil {
+		slog.Error("Failed to list role bindings from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	roleBindingsJson, err := json.Marshal(roleBindings)
+	if err != nil {
+		slog.Error("Failed to marshal role bindings list", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(roleBindingsJson)},
+		},
+	}, nil, nil
+}
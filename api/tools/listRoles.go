@@ -0,0 +1,47 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListRolesTool = &mcp.Tool{
+	Name:        "list_roles",
+	Description: "List the RBAC roles in the Kubernetes cluster",
+}
+
+type ListRolesToolParams struct {
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the roles"`
+}
+
+func ListRolesHandler(ctx context.Context, req *mcp.CallToolRequest, params ListRolesToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	roles, err := listAllPages(ctx, req, "roles", metav1.ListOptions{}, kubernetesApiClient.RbacV1().Roles(namespace).List)
+	if err != nil {
+		slog.Error("Failed to list roles from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	rolesJson, err := json.Marshal(roles)
+	if err != nil {
+		slog.Error("Failed to marshal roles list", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(rolesJson)},
+		},
+	}, nil, nil
+}
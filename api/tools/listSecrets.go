@@ -27,7 +27,7 @@ func ListSecretsHandler(ctx context.Context, req *mcp.CallToolRequest, params Li
 		namespace = *params.Namespace
 	}
 
-	secrets, err := kubernetesApiClient.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
+	secrets, err := listAllPages(ctx, req, "secrets", metav1.ListOptions{}, kubernetesApiClient.CoreV1().Secrets(namespace).List)
 	if err != nil {
 		return nil, nil, err
 	}
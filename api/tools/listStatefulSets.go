@@ -0,0 +1,57 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListStatefulSetsTool = &mcp.Tool{
+	Name:        "list_stateful_sets",
+	Description: "List the statefulsets in the Kubernetes cluster",
+}
+
+type ListStatefulSetsToolParams struct {
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace of the statefulsets"`
+	Summary   bool    `json:"summary,omitempty" jsonschema:"Return a summary of each statefulset's rollout status instead of the full objects"`
+}
+
+func ListStatefulSetsHandler(ctx context.Context, req *mcp.CallToolRequest, params ListStatefulSetsToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+
+	statefulSets, err := listAllPages(ctx, req, "stateful sets", metav1.ListOptions{}, kubernetesApiClient.AppsV1().StatefulSets(namespace).List)
+	if err != nil {
+		slog.Error("Failed to list statefulsets from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	var statefulSetsJson []byte
+	if params.Summary {
+		summaries := []StatefulSetSummary{}
+		for i := range statefulSets.Items {
+			summaries = append(summaries, summariseStatefulSet(&statefulSets.Items[i]))
+		}
+		statefulSetsJson, err = json.Marshal(summaries)
+	} else {
+		statefulSetsJson, err = json.Marshal(statefulSets)
+	}
+	if err != nil {
+		slog.Error("Failed to marshal statefulsets list", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(statefulSetsJson)},
+		},
+	}, nil, nil
+}
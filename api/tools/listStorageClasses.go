@@ -0,0 +1,38 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListStorageClassesTool = &mcp.Tool{
+	Name:        "list_storage_classes",
+	Description: "List the storage classes in the Kubernetes cluster",
+}
+
+func ListStorageClassesHandler(ctx context.Context, req *mcp.CallToolRequest, params any) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	storageClasses, err := listAllPages(ctx, req, "storage classes", metav1.ListOptions{}, kubernetesApiClient.StorageV1().StorageClasses().List)
+	if err != nil {
+		slog.Error("Failed to list storage classes from Kubernetes API", "tool", req.Params.Name, "error", err)
+		return nil, nil, err
+	}
+
+	storageClassesJson, err := json.Marshal(storageClasses)
+	if err != nil {
+		slog.Error("Failed to marshal storage classes list", "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(storageClassesJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,38 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ListVolumeAttachmentsTool = &mcp.Tool{
+	Name:        "list_volume_attachments",
+	Description: "List the volume attachments in the Kubernetes cluster",
+}
+
+func ListVolumeAttachmentsHandler(ctx context.Context, req *mcp.CallToolRequest, params any) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	volumeAttachments, err := listAllPages(ctx, req, "volume attachments", metav1.ListOptions{}, kubernetesApiClient.StorageV1().VolumeAttachments().List)
+	if err != nil {
+		slog.Error("Failed to list volume attachments from Kubernetes API", "tool", req.Params.Name, "error", err)
+		return nil, nil, err
+	}
+
+	volumeAttachmentsJson, err := json.Marshal(volumeAttachments)
+	if err != nil {
+		slog.Error("Failed to marshal volume attachments list", "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(volumeAttachmentsJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,104 @@
+package tools
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	metricsSortCPU    = "cpu"
+	metricsSortMemory = "memory"
+)
+
+// ResourceUsage compares the usage of a resource with its requests and limits,
+// or with the allocatable capacity of a node.
+type ResourceUsage struct {
+	Usage                string `json:"usage"`
+	Request              string `json:"request,omitempty"`
+	Limit                string `json:"limit,omitempty"`
+	Allocatable          string `json:"allocatable,omitempty"`
+	PercentOfRequest     *int64 `json:"percentOfRequest,omitempty"`
+	PercentOfLimit       *int64 `json:"percentOfLimit,omitempty"`
+	PercentOfAllocatable *int64 `json:"percentOfAllocatable,omitempty"`
+}
+
+// normaliseMetricsSort validates the sort order of a top tool, defaulting to cpu.
+func normaliseMetricsSort(sortBy *string) (string, error) {
+	if sortBy == nil || *sortBy == "" {
+		return metricsSortCPU, nil
+	}
+	switch strings.ToLower(*sortBy) {
+	case metricsSortCPU:
+		return metricsSortCPU, nil
+	case metricsSortMemory:
+		return metricsSortMemory, nil
+	}
+	return "", fmt.Errorf("unsupported sort %q, must be cpu or memory", *sortBy)
+}
+
+// formatQuantity formats cpu in millicores and memory in mebibytes, as shown by kubectl top.
+func formatQuantity(name corev1.ResourceName, quantity resource.Quantity) string {
+	if name == corev1.ResourceCPU {
+		return fmt.Sprintf("%dm", quantity.MilliValue())
+	}
+	return fmt.Sprintf("%dMi", quantity.Value()/(1024*1024))
+}
+
+// percentOf returns usage as a percentage of total, or nil if total is unset.
+func percentOf(usage resource.Quantity, total resource.Quantity) *int64 {
+	if total.IsZero() {
+		return nil
+	}
+	// Computed in floating point, as milli-values of large memory totals overflow int64.
+	percent := int64(usage.AsApproximateFloat64() * 100 / total.AsApproximateFloat64())
+	return &percent
+}
+
+// summariseResourceUsage compares the usage of a resource with its requests and limits.
+func summariseResourceUsage(name corev1.ResourceName, usage corev1.ResourceList, requests corev1.ResourceList, limits corev1.ResourceList) ResourceUsage {
+	summary := ResourceUsage{
+		Usage:            formatQuantity(name, usage[name]),
+		PercentOfRequest: percentOf(usage[name], requests[name]),
+		PercentOfLimit:   percentOf(usage[name], limits[name]),
+	}
+	if request, ok := requests[name]; ok {
+		summary.Request = formatQuantity(name, request)
+	}
+	if limit, ok := limits[name]; ok {
+		summary.Limit = formatQuantity(name, limit)
+	}
+	return summary
+}
+
+// addResourceList adds the quantities in a resource list to a total.
+func addResourceList(total corev1.ResourceList, list corev1.ResourceList) {
+	for name, quantity := range list {
+		sum := total[name]
+		sum.Add(quantity)
+		total[name] = sum
+	}
+}
+
+// isMetricsUnavailable checks whether an error is caused by the metrics API not
+// being served, e.g. when metrics-server is not installed or not ready.
+func isMetricsUnavailable(err error) bool {
+	return apierrors.IsNotFound(err) || apierrors.IsServiceUnavailable(err)
+}
+
+// metricsUnavailableResult explains that the metrics API is unavailable, rather than returning the raw API error.
+func metricsUnavailableResult(toolName string, err error) *mcp.CallToolResult {
+	slog.Warn("Metrics API unavailable", "tool", toolName, "error", err)
+	return &mcp.CallToolResult{
+		IsError: true,
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: fmt.Sprintf("metrics API unavailable: the metrics.k8s.io API is not served by the cluster, check metrics-server is installed and running (%v)", err)},
+		},
+	}
+}
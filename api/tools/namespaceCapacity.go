@@ -0,0 +1,236 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// quotaWarningPercent is the usage of a quota above which it is reported as nearly exhausted.
+const quotaWarningPercent = 90
+
+var NamespaceCapacityTool = &mcp.Tool{
+	Name:        "namespace_capacity",
+	Description: "Sum the CPU and memory requests and limits of the pods in a Kubernetes namespace and compare them with the namespace's resource quotas and limit ranges. Reports quotas which are nearly exhausted and containers without requests or limits",
+}
+
+type NamespaceCapacityToolParams struct {
+	Namespace string `json:"namespace" jsonschema:"The namespace to review"`
+}
+
+type QuotaResourceUsage struct {
+	Resource string `json:"resource"`
+	Hard     string `json:"hard"`
+	Used     string `json:"used"`
+	// Pods is the usage calculated from the pods in the namespace, for the resources it can be calculated for.
+	Pods    string `json:"pods,omitempty"`
+	Percent *int64 `json:"percent,omitempty"`
+}
+
+type QuotaUsage struct {
+	Name      string               `json:"name"`
+	Scopes    []string             `json:"scopes,omitempty"`
+	Resources []QuotaResourceUsage `json:"resources"`
+}
+
+type LimitRangeSummary struct {
+	Name   string                  `json:"name"`
+	Limits []corev1.LimitRangeItem `json:"limits"`
+}
+
+type NamespaceCapacityResult struct {
+	Namespace   string              `json:"namespace"`
+	Pods        int                 `json:"pods"`
+	CPU         ResourceAllocation  `json:"cpu"`
+	Memory      ResourceAllocation  `json:"memory"`
+	Quotas      []QuotaUsage        `json:"quotas"`
+	LimitRanges []LimitRangeSummary `json:"limitRanges"`
+	Findings    []Finding           `json:"findings"`
+}
+
+// podQuotaUsage calculates the usage of a quota resource from the totals of the pods
+// in a namespace, returning false for resources which it cannot, such as object
+// counts and persistent volume claim storage, which pods do not request.
+func podQuotaUsage(name corev1.ResourceName, pods int, requests corev1.ResourceList, limits corev1.ResourceList) (resource.Quantity, bool) {
+	switch {
+	case name == corev1.ResourceRequestsStorage || strings.HasPrefix(string(name), "count/"):
+		return resource.Quantity{}, false
+	case name == corev1.ResourcePods:
+		return *resource.NewQuantity(int64(pods), resource.DecimalSI), true
+	case strings.HasPrefix(string(name), "requests."):
+		return requests[corev1.ResourceName(strings.TrimPrefix(string(name), "requests."))], true
+	case strings.HasPrefix(string(name), "limits."):
+		return limits[corev1.ResourceName(strings.TrimPrefix(string(name), "limits."))], true
+	case name == corev1.ResourceCPU || name == corev1.ResourceMemory || name == corev1.ResourceEphemeralStorage:
+		return requests[name], true
+	}
+	return resource.Quantity{}, false
+}
+
+func NamespaceCapacityHandler(ctx context.Context, req *mcp.CallToolRequest, params NamespaceCapacityToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	pods, err := kubernetesApiClient.CoreV1().Pods(params.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		slog.Error("Failed to list pods from Kubernetes API", "tool", req.Params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+	quotas, err := kubernetesApiClient.CoreV1().ResourceQuotas(params.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		slog.Error("Failed to list resource quotas from Kubernetes API", "tool", req.Params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+	limitRanges, err := kubernetesApiClient.CoreV1().LimitRanges(params.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		slog.Error("Failed to list limit ranges from Kubernetes API", "tool", req.Params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	result := NamespaceCapacityResult{
+		Namespace:   params.Namespace,
+		Quotas:      []QuotaUsage{},
+		LimitRanges: []LimitRangeSummary{},
+		Findings:    []Finding{},
+	}
+
+	requests, limits := corev1.ResourceList{}, corev1.ResourceList{}
+	noRequests, noLimits := []string{}, []string{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if isPodTerminated(pod) {
+			continue
+		}
+		result.Pods++
+		podRequests, podLimits := podResourceTotals(pod)
+		addResourceList(requests, podRequests)
+		addResourceList(limits, podLimits)
+
+		for _, container := range pod.Spec.Containers {
+			missingRequests, missingLimits := []string{}, []string{}
+			for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+				if _, ok := container.Resources.Requests[name]; !ok {
+					missingRequests = append(missingRequests, string(name))
+				}
+				if _, ok := container.Resources.Limits[name]; !ok {
+					missingLimits = append(missingLimits, string(name))
+				}
+			}
+			if len(missingRequests) > 0 {
+				noRequests = append(noRequests, fmt.Sprintf("%s/%s: no %s request", pod.Name, container.Name, strings.Join(missingRequests, " or ")))
+			}
+			if len(missingLimits) > 0 {
+				noLimits = append(noLimits, fmt.Sprintf("%s/%s: no %s limit", pod.Name, container.Name, strings.Join(missingLimits, " or ")))
+			}
+		}
+	}
+
+	for _, quota := range quotas.Items {
+		usage := QuotaUsage{Name: quota.Name, Resources: []QuotaResourceUsage{}}
+		for _, scope := range quota.Spec.Scopes {
+			usage.Scopes = append(usage.Scopes, string(scope))
+		}
+
+		names := []corev1.ResourceName{}
+		for name := range quota.Status.Hard {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		exhausted := []string{}
+		for _, name := range names {
+			hard, used := quota.Status.Hard[name], quota.Status.Used[name]
+			resourceUsage := QuotaResourceUsage{
+				Resource: string(name),
+				Hard:     hard.String(),
+				Used:     used.String(),
+				Percent:  percentOf(used, hard),
+			}
+			// Scoped quotas only count some pods, so the pod totals are not comparable.
+			if len(quota.Spec.Scopes) == 0 && quota.Spec.ScopeSelector == nil {
+				if podUsage, ok := podQuotaUsage(name, result.Pods, requests, limits); ok {
+					resourceUsage.Pods = podUsage.String()
+				}
+			}
+			if resourceUsage.Percent != nil && *resourceUsage.Percent >= quotaWarningPercent {
+				exhausted = append(exhausted, fmt.Sprintf("%s: %s of %s used (%d%%)", name, used.String(), hard.String(), *resourceUsage.Percent))
+			}
+			usage.Resources = append(usage.Resources, resourceUsage)
+		}
+		if len(exhausted) > 0 {
+			result.Findings = append(result.Findings, Finding{
+				Type:     "QuotaNearlyExhausted",
+				Severity: severityWarning,
+				Message:  fmt.Sprintf("The resource quota %s is nearly exhausted, new pods or objects may be rejected", quota.Name),
+				Evidence: exhausted,
+			})
+		}
+		result.Quotas = append(result.Quotas, usage)
+	}
+
+	defaultsRequests, defaultsLimits := false, false
+	for _, limitRange := range limitRanges.Items {
+		result.LimitRanges = append(result.LimitRanges, LimitRangeSummary{Name: limitRange.Name, Limits: limitRange.Spec.Limits})
+		for _, limit := range limitRange.Spec.Limits {
+			if limit.Type == corev1.LimitTypeContainer {
+				defaultsRequests = defaultsRequests || len(limit.DefaultRequest) > 0 || len(limit.Default) > 0
+				defaultsLimits = defaultsLimits || len(limit.Default) > 0
+			}
+		}
+	}
+
+	if len(noRequests) > 0 {
+		finding := Finding{
+			Type:     "MissingRequests",
+			Severity: severityWarning,
+			Message:  fmt.Sprintf("%d containers have no CPU or memory request, so are not fully accounted for when scheduling", len(noRequests)),
+			Evidence: noRequests,
+		}
+		if defaultsRequests {
+			finding.Severity = severityInfo
+			finding.Message += ". A limit range sets defaults for new containers"
+		}
+		result.Findings = append(result.Findings, finding)
+	}
+	if len(noLimits) > 0 {
+		result.Findings = append(result.Findings, Finding{
+			Type:     "MissingLimits",
+			Severity: severityInfo,
+			Message:  fmt.Sprintf("%d containers have no CPU or memory limit, so can use any free capacity on their node", len(noLimits)),
+			Evidence: noLimits,
+		})
+	}
+	for _, quota := range quotas.Items {
+		_, limitsQuota := quota.Spec.Hard[corev1.ResourceLimitsMemory]
+		if limitsQuota && !defaultsLimits {
+			result.Findings = append(result.Findings, Finding{
+				Type:     "QuotaRequiresLimits",
+				Severity: severityWarning,
+				Message:  fmt.Sprintf("The resource quota %s limits memory limits but no limit range sets a default, so pods without memory limits are rejected", quota.Name),
+			})
+		}
+	}
+
+	result.CPU = summariseResourceAllocation(corev1.ResourceCPU, nil, requests, limits)
+	result.Memory = summariseResourceAllocation(corev1.ResourceMemory, nil, requests, limits)
+	sortFindings(result.Findings)
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal namespace capacity", "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
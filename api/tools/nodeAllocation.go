@@ -0,0 +1,149 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"slices"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var NodeAllocationTool = &mcp.Tool{
+	Name:        "node_allocation",
+	Description: "Show the allocatable CPU, memory and pods of nodes in the Kubernetes cluster compared with the requests and limits of the pods scheduled on them, like the allocated resources section of kubectl describe node",
+}
+
+type NodeAllocationToolParams struct {
+	LabelSelector *string `json:"labelSelector,omitempty" jsonschema:"A label selector to filter the nodes, e.g. node-role.kubernetes.io/worker"`
+	SortBy        *string `json:"sortBy,omitempty" jsonschema:"Sort the nodes by the percentage of cpu or memory requested, highest first. Defaults to cpu"`
+}
+
+type PodAllocation struct {
+	Allocatable int64  `json:"allocatable"`
+	Scheduled   int    `json:"scheduled"`
+	Percent     *int64 `json:"percent,omitempty"`
+}
+
+type NodeAllocation struct {
+	Name          string             `json:"name"`
+	Unschedulable bool               `json:"unschedulable,omitempty"`
+	CPU           ResourceAllocation `json:"cpu"`
+	Memory        ResourceAllocation `json:"memory"`
+	Pods          PodAllocation      `json:"pods"`
+}
+
+type NodeAllocationResult struct {
+	Nodes  []NodeAllocation   `json:"nodes"`
+	CPU    ResourceAllocation `json:"cpu"`
+	Memory ResourceAllocation `json:"memory"`
+}
+
+func NodeAllocationHandler(ctx context.Context, req *mcp.CallToolRequest, params NodeAllocationToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	listOptions := metav1.ListOptions{}
+	if params.LabelSelector != nil {
+		listOptions.LabelSelector = *params.LabelSelector
+	}
+	sortBy, err := normaliseMetricsSort(params.SortBy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nodes, err := kubernetesApiClient.CoreV1().Nodes().List(ctx, listOptions)
+	if err != nil {
+		slog.Error("Failed to list nodes from Kubernetes API", "tool", req.Params.Name, "error", err)
+		return nil, nil, err
+	}
+	pods, err := listAllPages(ctx, req, "pods", metav1.ListOptions{
+		FieldSelector: "status.phase!=Succeeded,status.phase!=Failed",
+	}, kubernetesApiClient.CoreV1().Pods(metav1.NamespaceAll).List)
+	if err != nil {
+		slog.Error("Failed to list pods from Kubernetes API", "tool", req.Params.Name, "error", err)
+		return nil, nil, err
+	}
+
+	type nodeTotals struct {
+		requests corev1.ResourceList
+		limits   corev1.ResourceList
+		pods     int
+	}
+	totals := map[string]*nodeTotals{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName == "" || isPodTerminated(pod) {
+			continue
+		}
+		node, ok := totals[pod.Spec.NodeName]
+		if !ok {
+			node = &nodeTotals{requests: corev1.ResourceList{}, limits: corev1.ResourceList{}}
+			totals[pod.Spec.NodeName] = node
+		}
+		requests, limits := podResourceTotals(pod)
+		addResourceList(node.requests, requests)
+		addResourceList(node.limits, limits)
+		node.pods++
+	}
+
+	result := NodeAllocationResult{Nodes: []NodeAllocation{}}
+	clusterAllocatable, clusterRequests, clusterLimits := corev1.ResourceList{}, corev1.ResourceList{}, corev1.ResourceList{}
+	for _, node := range nodes.Items {
+		nodeTotal, ok := totals[node.Name]
+		if !ok {
+			nodeTotal = &nodeTotals{requests: corev1.ResourceList{}, limits: corev1.ResourceList{}}
+		}
+		allocatable := node.Status.Allocatable
+		cpu, memory, podCapacity := allocatable[corev1.ResourceCPU], allocatable[corev1.ResourceMemory], allocatable[corev1.ResourcePods]
+
+		result.Nodes = append(result.Nodes, NodeAllocation{
+			Name:          node.Name,
+			Unschedulable: node.Spec.Unschedulable,
+			CPU:           summariseResourceAllocation(corev1.ResourceCPU, &cpu, nodeTotal.requests, nodeTotal.limits),
+			Memory:        summariseResourceAllocation(corev1.ResourceMemory, &memory, nodeTotal.requests, nodeTotal.limits),
+			Pods: PodAllocation{
+				Allocatable: podCapacity.Value(),
+				Scheduled:   nodeTotal.pods,
+				Percent:     percentOf(*resource.NewQuantity(int64(nodeTotal.pods), resource.DecimalSI), podCapacity),
+			},
+		})
+		addResourceList(clusterAllocatable, allocatable)
+		addResourceList(clusterRequests, nodeTotal.requests)
+		addResourceList(clusterLimits, nodeTotal.limits)
+	}
+
+	clusterCPU, clusterMemory := clusterAllocatable[corev1.ResourceCPU], clusterAllocatable[corev1.ResourceMemory]
+	result.CPU = summariseResourceAllocation(corev1.ResourceCPU, &clusterCPU, clusterRequests, clusterLimits)
+	result.Memory = summariseResourceAllocation(corev1.ResourceMemory, &clusterMemory, clusterRequests, clusterLimits)
+
+	percentRequested := func(node NodeAllocation) int64 {
+		allocation := node.CPU
+		if sortBy == metricsSortMemory {
+			allocation = node.Memory
+		}
+		if allocation.PercentRequested == nil {
+			return -1
+		}
+		return *allocation.PercentRequested
+	}
+	slices.SortStableFunc(result.Nodes, func(a, b NodeAllocation) int {
+		return cmp.Compare(percentRequested(b), percentRequested(a))
+	})
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal node allocation", "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,148 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// maxOwnerDepth bounds owner reference walks to guard against reference cycles.
+const maxOwnerDepth = 10
+
+type OwnerSummary struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace,omitempty"`
+	Controller bool   `json:"controller"`
+}
+
+// primaryOwner returns the controller owner reference of an object, or its
+// first owner reference if it has no controller.
+func primaryOwner(object metav1.Object) *metav1.OwnerReference {
+	if controller := metav1.GetControllerOf(object); controller != nil {
+		return controller
+	}
+	if references := object.GetOwnerReferences(); len(references) > 0 {
+		return &references[0]
+	}
+	return nil
+}
+
+// getOwner fetches the object referenced by an owner reference. Owners are
+// either in the same namespace as the owned object or cluster scoped.
+func getOwner(ctx context.Context, reference *metav1.OwnerReference, namespace string) (*unstructured.Unstructured, error) {
+	mapping, err := resolveRESTMapping(schema.FromAPIVersionAndKind(reference.APIVersion, reference.Kind))
+	if err != nil {
+		return nil, err
+	}
+	return resourceInterfaceFor(mapping, namespace).Get(ctx, reference.Name, metav1.GetOptions{})
+}
+
+// resolveOwnerChain walks owner references up from an object to its top-level
+// controller, returning the owners from nearest to furthest.
+func resolveOwnerChain(ctx context.Context, object *unstructured.Unstructured) ([]OwnerSummary, error) {
+	owners := []OwnerSummary{}
+
+	current := object
+	for range maxOwnerDepth {
+		reference := primaryOwner(current)
+		if reference == nil {
+			break
+		}
+
+		owner, err := getOwner(ctx, reference, object.GetNamespace())
+		summary := OwnerSummary{
+			APIVersion: reference.APIVersion,
+			Kind:       reference.Kind,
+			Name:       reference.Name,
+			Controller: reference.Controller != nil && *reference.Controller,
+		}
+		if err != nil {
+			// The owner may have been deleted or be inaccessible, report what is known.
+			owners = append(owners, summary)
+			return owners, err
+		}
+
+		summary.Namespace = owner.GetNamespace()
+		owners = append(owners, summary)
+		current = owner
+	}
+	return owners, nil
+}
+
+// summariseObjectStatus returns a short, kubectl style status for common
+// workload kinds, falling back to the status phase or Ready condition.
+func summariseObjectStatus(object *unstructured.Unstructured) string {
+	content := object.UnstructuredContent()
+
+	switch object.GetKind() {
+	case "Pod":
+		phase, _, _ := unstructured.NestedString(content, "status", "phase")
+		statuses, _, _ := unstructured.NestedSlice(content, "status", "containerStatuses")
+		ready := 0
+		for _, status := range statuses {
+			container, ok := status.(map[string]any)
+			if !ok {
+				continue
+			}
+			if isReady, _, _ := unstructured.NestedBool(container, "ready"); isReady {
+				ready++
+			}
+			if reason, _, _ := unstructured.NestedString(container, "state", "waiting", "reason"); reason != "" {
+				phase = reason
+			}
+		}
+		return fmt.Sprintf("%s %d/%d ready", phase, ready, len(statuses))
+	case "DaemonSet":
+		ready, _, _ := unstructured.NestedInt64(content, "status", "numberReady")
+		desired, _, _ := unstructured.NestedInt64(content, "status", "desiredNumberScheduled")
+		return fmt.Sprintf("%d/%d ready", ready, desired)
+	case "Job":
+		succeeded, _, _ := unstructured.NestedInt64(content, "status", "succeeded")
+		completions, found, _ := unstructured.NestedInt64(content, "spec", "completions")
+		if !found {
+			completions = 1
+		}
+		if condition := trueCondition(content, "Complete", "Failed"); condition != "" {
+			return fmt.Sprintf("%s %d/%d", condition, succeeded, completions)
+		}
+		return fmt.Sprintf("Running %d/%d", succeeded, completions)
+	case "CronJob":
+		if suspended, _, _ := unstructured.NestedBool(content, "spec", "suspend"); suspended {
+			return "Suspended"
+		}
+		active, _, _ := unstructured.NestedSlice(content, "status", "active")
+		return fmt.Sprintf("%d active", len(active))
+	}
+
+	// Replica based workloads, including CRDs such as Argo Rollouts.
+	if replicas, found, _ := unstructured.NestedInt64(content, "spec", "replicas"); found {
+		ready, _, _ := unstructured.NestedInt64(content, "status", "readyReplicas")
+		return fmt.Sprintf("%d/%d ready", ready, replicas)
+	}
+	if phase, _, _ := unstructured.NestedString(content, "status", "phase"); phase != "" {
+		return phase
+	}
+	if condition := trueCondition(content, "Ready", "Available"); condition != "" {
+		return condition
+	}
+	return ""
+}
+
+// trueCondition returns the first of the given condition types which is "True" on the object.
+func trueCondition(content map[string]any, conditionTypes ...string) string {
+	conditions, _, _ := unstructured.NestedSlice(content, "status", "conditions")
+	for _, conditionType := range conditionTypes {
+		for _, item := range conditions {
+			condition, ok := item.(map[string]any)
+			if ok && condition["type"] == conditionType && condition["status"] == "True" {
+				return conditionType
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,86 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// listPageSize is the number of objects requested per page by listAllPages.
+const listPageSize = 500
+
+// notifyProgress sends a progress notification for the tool call if the caller
+// supplied a progress token. Failures are logged rather than failing the call.
+func notifyProgress(ctx context.Context, req *mcp.CallToolRequest, progress float64, total float64, message string) {
+	progressToken := req.Params.GetProgressToken()
+	if progressToken == nil || req.Session == nil {
+		return
+	}
+
+	err := req.Session.NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+		ProgressToken: progressToken,
+		Progress:      progress,
+		Total:         total,
+		Message:       message,
+	})
+	if err != nil {
+		slog.Warn("Failed to send progress notification", "tool", req.Params.Name, "error", err)
+	}
+}
+
+// listAllPages lists objects in pages of listPageSize, sending a progress
+// notification after each page, and returns the pages merged into one list.
+// The context is cancelled by the SDK when the caller cancels the tool call,
+// which stops any further pages being requested.
+func listAllPages[L runtime.Object](ctx context.Context, req *mcp.CallToolRequest, resource string, options metav1.ListOptions, list func(context.Context, metav1.ListOptions) (L, error)) (L, error) {
+	var result L
+	items := []runtime.Object{}
+	options.Limit = listPageSize
+	for first := true; ; first = false {
+		page, err := list(ctx, options)
+		if err != nil {
+			return result, err
+		}
+		pageItems, err := meta.ExtractList(page)
+		if err != nil {
+			return result, err
+		}
+		items = append(items, pageItems...)
+		pageMeta, err := meta.ListAccessor(page)
+		if err != nil {
+			return result, err
+		}
+		if first {
+			result = page
+		}
+
+		// The total is only known when the API server reports the remaining item count.
+		total := 0.0
+		if remaining := pageMeta.GetRemainingItemCount(); remaining != nil {
+			total = float64(len(items)) + float64(*remaining)
+		}
+		notifyProgress(ctx, req, float64(len(items)), total, fmt.Sprintf("Listed %d %s", len(items), resource))
+
+		if pageMeta.GetContinue() == "" {
+			break
+		}
+		options.Continue = pageMeta.GetContinue()
+	}
+
+	if err := meta.SetList(result, items); err != nil {
+		return result, err
+	}
+	resultMeta, err := meta.ListAccessor(result)
+	if err != nil {
+		return result, err
+	}
+	resultMeta.SetContinue("")
+	resultMeta.SetRemainingItemCount(nil)
+	return result, nil
+}
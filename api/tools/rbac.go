@@ -0,0 +1,73 @@
+package tools
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// AccessResource identifies the resource an access check is made against.
+type AccessResource struct {
+	Group       string `json:"group"`
+	Resource    string `json:"resource"`
+	Subresource string `json:"subresource,omitempty"`
+}
+
+// serviceAccountUsername returns the username a service account authenticates as.
+func serviceAccountUsername(namespace string, name string) string {
+	return fmt.Sprintf("system:serviceaccount:%s:%s", namespace, name)
+}
+
+// serviceAccountGroups returns the groups a service account is a member of.
+func serviceAccountGroups(namespace string) []string {
+	return []string{"system:serviceaccounts", "system:serviceaccounts:" + namespace, "system:authenticated"}
+}
+
+// resolveAccessResource resolves the API group of a resource from discovery
+// when not given, e.g. "deployments" to apps. Subresources are separated by a
+// slash, e.g. pods/log. Wildcards and unknown resources are used as given.
+func resolveAccessResource(resource string, group *string) AccessResource {
+	resource, subresource, _ := strings.Cut(resource, "/")
+	access := AccessResource{Resource: resource, Subresource: subresource}
+	if group != nil {
+		access.Group = *group
+		return access
+	}
+	if resource == rbacv1.ResourceAll {
+		return access
+	}
+	if mapping, err := resolveKindMapping("", resource); err == nil {
+		access.Group = mapping.Resource.Group
+		access.Resource = mapping.Resource.Resource
+	}
+	return access
+}
+
+// ruleMatches checks whether a policy rule matches the given value, honouring wildcards.
+func ruleMatches(values []string, value string) bool {
+	return slices.Contains(values, rbacv1.ResourceAll) || slices.Contains(values, value)
+}
+
+// ruleAllows checks whether an RBAC policy rule grants a verb on a resource.
+// An empty name matches rules without resourceNames only, as rules restricted
+// to named objects don't grant access to all objects.
+func ruleAllows(rule rbacv1.PolicyRule, verb string, resource AccessResource, name string) bool {
+	if !ruleMatches(rule.Verbs, verb) || !ruleMatches(rule.APIGroups, resource.Group) {
+		return false
+	}
+	if resource.Subresource == "" {
+		if !ruleMatches(rule.Resources, resource.Resource) {
+			return false
+		}
+	} else if !ruleMatches(rule.Resources, resource.Resource+"/"+resource.Subresource) &&
+		!slices.Contains(rule.Resources, resource.Resource+"/"+rbacv1.ResourceAll) &&
+		!slices.Contains(rule.Resources, rbacv1.ResourceAll+"/"+resource.Subresource) {
+		return false
+	}
+	if len(rule.ResourceNames) == 0 {
+		return true
+	}
+	return name != "" && slices.Contains(rule.ResourceNames, name)
+}
@@ -0,0 +1,128 @@
+package tools
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+)
+
+// dataKindTools are the typed get tools guarding kinds whose objects hold
+// data which may be sensitive, keyed by resource.
+var dataKindTools = map[schema.GroupResource]string{
+	{Resource: "secrets"}:    "get_secret",
+	{Resource: "configmaps"}: "get_config_map",
+}
+
+// checkKindAllowed refuses generic reads of a kind whose typed get tool is
+// disallowed, so that generic tools can't be used to get around the disallowed tools.
+func checkKindAllowed(mapping *meta.RESTMapping) error {
+	if tool, ok := dataKindTools[mapping.Resource.GroupResource()]; ok && !IsToolAllowed(tool) {
+		return fmt.Errorf("reading %s is not permitted as %s is disallowed", mapping.Resource.Resource, tool)
+	}
+	return nil
+}
+
+// resolveRESTMapping resolves the REST mapping for a kind, refreshing the
+// cached discovery information once if the kind is not found (e.g. a newly
+// installed CRD).
+func resolveRESTMapping(gvk schema.GroupVersionKind) (*meta.RESTMapping, error) {
+	mapping, err := kubernetesRESTMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		kubernetesRESTMapper.Reset()
+		mapping, err = kubernetesRESTMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	}
+	return mapping, err
+}
+
+// resolveKindMapping resolves the REST mapping for a user supplied kind or
+// resource, e.g. "Deployment", "deployments.apps" or the short name "svc".
+// If apiVersion is set the kind is resolved against that group version.
+func resolveKindMapping(apiVersion string, kind string) (*meta.RESTMapping, error) {
+	if apiVersion != "" {
+		return resolveRESTMapping(schema.FromAPIVersionAndKind(apiVersion, kind))
+	}
+
+	gvr, gr := schema.ParseResourceArg(strings.ToLower(strings.TrimSpace(kind)))
+	kindFor := func() (schema.GroupVersionKind, error) {
+		if gvr != nil {
+			if gvk, err := kubernetesShortcutRESTMapper.KindFor(*gvr); err == nil {
+				return gvk, nil
+			}
+		}
+		return kubernetesShortcutRESTMapper.KindFor(gr.WithVersion(""))
+	}
+
+	gvk, err := kindFor()
+	if meta.IsNoMatchError(err) {
+		kubernetesRESTMapper.Reset()
+		gvk, err = kindFor()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return kubernetesRESTMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+}
+
+// resourceInterfaceFor returns a dynamic client for the mapped resource,
+// scoped to the namespace when the resource is namespaced.
+func resourceInterfaceFor(mapping *meta.RESTMapping, namespace string) dynamic.ResourceInterface {
+	resource := kubernetesDynamicClient.Resource(mapping.Resource)
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return resource.Namespace(namespace)
+	}
+	return resource
+}
+
+// decodeManifest decodes a (multi-document) YAML or JSON manifest into
+// unstructured objects, skipping empty documents and expanding List documents
+// into their items.
+func decodeManifest(manifest string) ([]*unstructured.Unstructured, error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+
+	objects := []*unstructured.Unstructured{}
+	document := 0
+	for {
+		object := &unstructured.Unstructured{}
+		if err := decoder.Decode(&object.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to decode manifest document %d: %w", document+1, err)
+		}
+		if len(object.Object) == 0 {
+			continue
+		}
+		document++
+
+		// Lists, e.g. the output of kubectl get -o yaml, are expanded into their items.
+		items := []*unstructured.Unstructured{object}
+		if object.IsList() {
+			list, err := object.ToList()
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode manifest document %d (%s): %w", document, object.GetKind(), err)
+			}
+			items = []*unstructured.Unstructured{}
+			for i := range list.Items {
+				items = append(items, &list.Items[i])
+			}
+		}
+
+		for _, item := range items {
+			if item.GetKind() == "" || item.GetAPIVersion() == "" {
+				return nil, fmt.Errorf("manifest document %d is missing apiVersion or kind", document)
+			}
+			if item.GetName() == "" {
+				return nil, fmt.Errorf("manifest document %d (%s) is missing metadata.name", document, item.GetKind())
+			}
+			objects = append(objects, item)
+		}
+	}
+	return objects, nil
+}
@@ -0,0 +1,99 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// restartedAtAnnotation is the pod template annotation used by `kubectl rollout restart`.
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+var RestartWorkloadTool = &mcp.Tool{
+	Name:        "restart_workload",
+	Description: "Trigger a rolling restart of a deployment, statefulset or daemonset in the Kubernetes cluster",
+}
+
+type RestartWorkloadToolParams struct {
+	Kind      string `json:"kind" jsonschema:"The kind of workload to restart: Deployment, StatefulSet or DaemonSet"`
+	Name      string `json:"name" jsonschema:"The name of the workload"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the workload"`
+}
+
+type RestartWorkloadResult struct {
+	Kind        string `json:"kind"`
+	Name        string `json:"name"`
+	Namespace   string `json:"namespace"`
+	RestartedAt string `json:"restartedAt"`
+}
+
+func RestartWorkloadHandler(ctx context.Context, req *mcp.CallToolRequest, params RestartWorkloadToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	kind, err := normaliseWorkloadKind(params.Kind)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	action := fmt.Sprintf("restart %s %s in namespace %s", strings.ToLower(kind), params.Name, params.Namespace)
+	if err := confirmAction(ctx, req, action); err != nil {
+		return nil, nil, err
+	}
+
+	restartedAt := time.Now().Format(time.RFC3339)
+	patch, err := json.Marshal(map[string]any{
+		"spec": map[string]any{
+			"template": map[string]any{
+				"metadata": map[string]any{
+					"annotations": map[string]string{
+						restartedAtAnnotation: restartedAt,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch kind {
+	case workloadKindDeployment:
+		_, err = kubernetesApiClient.AppsV1().Deployments(params.Namespace).Patch(ctx, params.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case workloadKindStatefulSet:
+		_, err = kubernetesApiClient.AppsV1().StatefulSets(params.Namespace).Patch(ctx, params.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case workloadKindDaemonSet:
+		_, err = kubernetesApiClient.AppsV1().DaemonSets(params.Namespace).Patch(ctx, params.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	default:
+		err = fmt.Errorf("%s workloads cannot be restarted", kind)
+	}
+	if err != nil {
+		slog.Error("Failed to restart workload", "tool", req.Params.Name, "kind", kind, "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	slog.Info("Workload restarted", "kind", kind, "name", params.Name, "namespace", params.Namespace)
+
+	resultJson, err := json.Marshal(RestartWorkloadResult{
+		Kind:        kind,
+		Name:        params.Name,
+		Namespace:   params.Namespace,
+		RestartedAt: restartedAt,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
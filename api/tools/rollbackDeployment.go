@@ -0,0 +1,180 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strconv"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	revisionAnnotation = "deployment.kubernetes.io/revision"
+	podTemplateHashKey = "pod-template-hash"
+)
+
+// rollbackSkippedAnnotations are replicaset annotations which are not copied
+// back onto the deployment during a rollback, matching `kubectl rollout undo`.
+var rollbackSkippedAnnotations = map[string]bool{
+	"kubectl.kubernetes.io/last-applied-configuration": true,
+	revisionAnnotation:                          true,
+	"deployment.kubernetes.io/revision-history": true,
+	"deployment.kubernetes.io/desired-replicas": true,
+	"deployment.kubernetes.io/max-replicas":     true,
+	"deprecated.deployment.rollback.to":         true,
+}
+
+var RollbackDeploymentTool = &mcp.Tool{
+	Name:        "rollback_deployment",
+	Description: "Roll back a deployment in the Kubernetes cluster to a previous replicaset revision",
+}
+
+type RollbackDeploymentToolParams struct {
+	Name       string `json:"name" jsonschema:"The name of the deployment"`
+	Namespace  string `json:"namespace" jsonschema:"The namespace of the deployment"`
+	ToRevision *int64 `json:"toRevision,omitempty" jsonschema:"The revision to roll back to, defaults to the previous revision"`
+}
+
+type RollbackDeploymentResult struct {
+	Name         string `json:"name"`
+	Namespace    string `json:"namespace"`
+	FromRevision int64  `json:"fromRevision"`
+	ToRevision   int64  `json:"toRevision"`
+	ReplicaSet   string `json:"replicaSet"`
+}
+
+// getRevision returns the deployment revision recorded on an object, or 0 if not set.
+func getRevision(obj metav1.Object) int64 {
+	revision, err := strconv.ParseInt(obj.GetAnnotations()[revisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
+
+// listDeploymentReplicaSets lists the replicasets controlled by the given deployment.
+func listDeploymentReplicaSets(ctx context.Context, deployment *appsv1.Deployment) ([]appsv1.ReplicaSet, error) {
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	replicaSets, err := kubernetesApiClient.AppsV1().ReplicaSets(deployment.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	owned := []appsv1.ReplicaSet{}
+	for _, replicaSet := range replicaSets.Items {
+		if owner := metav1.GetControllerOf(&replicaSet); owner != nil && owner.UID == deployment.UID {
+			owned = append(owned, replicaSet)
+		}
+	}
+	return owned, nil
+}
+
+func RollbackDeploymentHandler(ctx context.Context, req *mcp.CallToolRequest, params RollbackDeploymentToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	deployment, err := kubernetesApiClient.AppsV1().Deployments(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get deployment from Kubernetes API", "tool", req.Params.Name, "deployment", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+	if deployment.Spec.Paused {
+		return nil, nil, fmt.Errorf("deployment %q is paused, resume it before rolling back", params.Name)
+	}
+
+	replicaSets, err := listDeploymentReplicaSets(ctx, deployment)
+	if err != nil {
+		slog.Error("Failed to list deployment replicasets", "tool", req.Params.Name, "deployment", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	currentRevision := getRevision(deployment)
+	var target *appsv1.ReplicaSet
+	for i := range replicaSets {
+		revision := getRevision(&replicaSets[i])
+		if params.ToRevision != nil && *params.ToRevision > 0 {
+			if revision == *params.ToRevision {
+				target = &replicaSets[i]
+				break
+			}
+			continue
+		}
+		// Default to the most recent revision prior to the current one.
+		if revision < currentRevision && (target == nil || revision > getRevision(target)) {
+			target = &replicaSets[i]
+		}
+	}
+	if target == nil {
+		if params.ToRevision != nil && *params.ToRevision > 0 {
+			return nil, nil, fmt.Errorf("unable to find revision %d of deployment %q", *params.ToRevision, params.Name)
+		}
+		return nil, nil, fmt.Errorf("no previous revision found for deployment %q", params.Name)
+	}
+
+	template := target.Spec.Template.DeepCopy()
+	delete(template.Labels, podTemplateHashKey)
+	if equality.Semantic.DeepEqual(template, &deployment.Spec.Template) {
+		return nil, nil, fmt.Errorf("deployment %q is already at the template of revision %d", params.Name, getRevision(target))
+	}
+
+	action := fmt.Sprintf("roll back deployment %s in namespace %s from revision %d to revision %d", params.Name, params.Namespace, currentRevision, getRevision(target))
+	if err := confirmAction(ctx, req, action); err != nil {
+		return nil, nil, err
+	}
+
+	annotations := maps.Clone(deployment.Annotations)
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	for key, value := range target.Annotations {
+		if !rollbackSkippedAnnotations[key] {
+			annotations[key] = value
+		}
+	}
+
+	patch, err := json.Marshal([]map[string]any{
+		{"op": "replace", "path": "/spec/template", "value": template},
+		{"op": "replace", "path": "/metadata/annotations", "value": annotations},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = kubernetesApiClient.AppsV1().Deployments(params.Namespace).Patch(ctx, params.Name, types.JSONPatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		slog.Error("Failed to roll back deployment", "tool", req.Params.Name, "deployment", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	slog.Info("Deployment rolled back", "deployment", params.Name, "namespace", params.Namespace, "revision", getRevision(target))
+
+	resultJson, err := json.Marshal(RollbackDeploymentResult{
+		Name:         params.Name,
+		Namespace:    params.Namespace,
+		FromRevision: currentRevision,
+		ToRevision:   getRevision(target),
+		ReplicaSet:   target.Name,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,100 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ScaleWorkloadTool = &mcp.Tool{
+	Name:        "scale_workload",
+	Description: "Scale a deployment or statefulset in the Kubernetes cluster to the given number of replicas",
+}
+
+type ScaleWorkloadToolParams struct {
+	Kind      string `json:"kind" jsonschema:"The kind of workload to scale: Deployment or StatefulSet"`
+	Name      string `json:"name" jsonschema:"The name of the workload"`
+	Namespace string `json:"namespace" jsonschema:"The namespace of the workload"`
+	Replicas  int32  `json:"replicas" jsonschema:"The desired number of replicas"`
+}
+
+type ScaleWorkloadResult struct {
+	Kind             string `json:"kind"`
+	Name             string `json:"name"`
+	Namespace        string `json:"namespace"`
+	PreviousReplicas int32  `json:"previousReplicas"`
+	Replicas         int32  `json:"replicas"`
+}
+
+func ScaleWorkloadHandler(ctx context.Context, req *mcp.CallToolRequest, params ScaleWorkloadToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	kind, err := normaliseWorkloadKind(params.Kind)
+	if err != nil {
+		return nil, nil, err
+	}
+	if params.Replicas < 0 {
+		return nil, nil, fmt.Errorf("replicas must not be negative, got %d", params.Replicas)
+	}
+
+	var (
+		getScale    func(ctx context.Context, name string, opts metav1.GetOptions) (*autoscalingv1.Scale, error)
+		updateScale func(ctx context.Context, name string, scale *autoscalingv1.Scale, opts metav1.UpdateOptions) (*autoscalingv1.Scale, error)
+	)
+	switch kind {
+	case workloadKindDeployment:
+		deployments := kubernetesApiClient.AppsV1().Deployments(params.Namespace)
+		getScale, updateScale = deployments.GetScale, deployments.UpdateScale
+	case workloadKindStatefulSet:
+		statefulSets := kubernetesApiClient.AppsV1().StatefulSets(params.Namespace)
+		getScale, updateScale = statefulSets.GetScale, statefulSets.UpdateScale
+	default:
+		return nil, nil, fmt.Errorf("%s workloads cannot be scaled", kind)
+	}
+
+	scale, err := getScale(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get workload scale from Kubernetes API", "tool", req.Params.Name, "kind", kind, "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	previousReplicas := scale.Spec.Replicas
+	action := fmt.Sprintf("scale %s %s in namespace %s from %d to %d replicas", strings.ToLower(kind), params.Name, params.Namespace, previousReplicas, params.Replicas)
+	if err := confirmAction(ctx, req, action); err != nil {
+		return nil, nil, err
+	}
+
+	scale.Spec.Replicas = params.Replicas
+
+	scale, err = updateScale(ctx, params.Name, scale, metav1.UpdateOptions{})
+	if err != nil {
+		slog.Error("Failed to update workload scale", "tool", req.Params.Name, "kind", kind, "name", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	slog.Info("Workload scaled", "kind", kind, "name", params.Name, "namespace", params.Namespace, "from", previousReplicas, "to", scale.Spec.Replicas)
+
+	resultJson, err := json.Marshal(ScaleWorkloadResult{
+		Kind:             kind,
+		Name:             params.Name,
+		Namespace:        params.Namespace,
+		PreviousReplicas: previousReplicas,
+		Replicas:         scale.Spec.Replicas,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,253 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// dangerousCapabilities are the Linux capabilities which effectively grant root on the node.
+var dangerousCapabilities = []corev1.Capability{"ALL", "SYS_ADMIN", "NET_ADMIN", "SYS_PTRACE", "SYS_MODULE", "DAC_READ_SEARCH"}
+
+var SecurityPostureTool = &mcp.Tool{
+	Name:        "security_posture",
+	Description: "Review the security posture of the pods in the Kubernetes cluster. Flags privileged containers, containers running as root or with dangerous capabilities, host path volumes, host namespaces, missing resource limits and images using the latest tag, grouped by workload",
+}
+
+type SecurityPostureToolParams struct {
+	Namespaces    []string `json:"namespaces,omitempty" jsonschema:"The namespaces to review, defaults to all namespaces"`
+	LabelSelector *string  `json:"labelSelector,omitempty" jsonschema:"A label selector to filter the pods, e.g. app=web"`
+}
+
+type WorkloadSecurityPosture struct {
+	Namespace string    `json:"namespace"`
+	Workload  string    `json:"workload"`
+	Pods      int       `json:"pods"`
+	Findings  []Finding `json:"findings"`
+}
+
+type SecurityPostureResult struct {
+	Pods      int                       `json:"pods"`
+	Counts    map[string]int            `json:"counts"`
+	Workloads []WorkloadSecurityPosture `json:"workloads"`
+}
+
+// containerRunAsUser resolves the effective user and non-root requirement of a
+// container, where the container security context overrides the pod's.
+func containerRunAsUser(pod *corev1.Pod, container *corev1.Container) (*int64, bool) {
+	var runAsUser *int64
+	runAsNonRoot := false
+	if podContext := pod.Spec.SecurityContext; podContext != nil {
+		runAsUser = podContext.RunAsUser
+		runAsNonRoot = podContext.RunAsNonRoot != nil && *podContext.RunAsNonRoot
+	}
+	if containerContext := container.SecurityContext; containerContext != nil {
+		if containerContext.RunAsUser != nil {
+			runAsUser = containerContext.RunAsUser
+		}
+		if containerContext.RunAsNonRoot != nil {
+			runAsNonRoot = *containerContext.RunAsNonRoot
+		}
+	}
+	return runAsUser, runAsNonRoot
+}
+
+// podSecurityFindings checks a pod and its containers against common security recommendations.
+func podSecurityFindings(pod *corev1.Pod) []Finding {
+	findings := []Finding{}
+
+	hostNamespaces := []string{}
+	if pod.Spec.HostNetwork {
+		hostNamespaces = append(hostNamespaces, "hostNetwork")
+	}
+	if pod.Spec.HostPID {
+		hostNamespaces = append(hostNamespaces, "hostPID")
+	}
+	if pod.Spec.HostIPC {
+		hostNamespaces = append(hostNamespaces, "hostIPC")
+	}
+	if len(hostNamespaces) > 0 {
+		findings = append(findings, Finding{
+			Type:     "HostNamespaces",
+			Severity: severityWarning,
+			Message:  fmt.Sprintf("The pod shares the node's namespaces: %s", strings.Join(hostNamespaces, ", ")),
+		})
+	}
+
+	hostPaths := []string{}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.HostPath != nil {
+			hostPaths = append(hostPaths, fmt.Sprintf("volume %s mounts %s", volume.Name, volume.HostPath.Path))
+		}
+	}
+	if len(hostPaths) > 0 {
+		findings = append(findings, Finding{
+			Type:     "HostPathVolume",
+			Severity: severityWarning,
+			Message:  "The pod mounts paths from the node's filesystem",
+			Evidence: hostPaths,
+		})
+	}
+
+	// Ephemeral containers can't set resources and init containers which run to
+	// completion before the pod starts don't compete for resources, so only app
+	// and sidecar containers need limits.
+	limitsExempt := map[string]bool{}
+	for _, container := range pod.Spec.EphemeralContainers {
+		limitsExempt[container.Name] = true
+	}
+	for _, container := range pod.Spec.InitContainers {
+		if container.RestartPolicy == nil || *container.RestartPolicy != corev1.ContainerRestartPolicyAlways {
+			limitsExempt[container.Name] = true
+		}
+	}
+
+	containers, _ := podContainers(pod)
+	for i := range containers {
+		container := &containers[i]
+		securityContext := container.SecurityContext
+
+		if securityContext != nil && securityContext.Privileged != nil && *securityContext.Privileged {
+			findings = append(findings, Finding{
+				Type:     "Privileged",
+				Severity: severityCritical,
+				Message:  fmt.Sprintf("Container %s runs privileged, with full access to the node", container.Name),
+			})
+		}
+		if securityContext != nil && securityContext.Capabilities != nil {
+			added := []string{}
+			for _, capability := range securityContext.Capabilities.Add {
+				if slices.Contains(dangerousCapabilities, corev1.Capability(strings.TrimPrefix(strings.ToUpper(string(capability)), "CAP_"))) {
+					added = append(added, string(capability))
+				}
+			}
+			if len(added) > 0 {
+				findings = append(findings, Finding{
+					Type:     "DangerousCapabilities",
+					Severity: severityWarning,
+					Message:  fmt.Sprintf("Container %s adds the capabilities %s", container.Name, strings.Join(added, ", ")),
+				})
+			}
+		}
+
+		runAsUser, runAsNonRoot := containerRunAsUser(pod, container)
+		switch {
+		case runAsUser != nil && *runAsUser == 0:
+			findings = append(findings, Finding{
+				Type:     "RunAsRoot",
+				Severity: severityWarning,
+				Message:  fmt.Sprintf("Container %s runs as root (uid 0)", container.Name),
+			})
+		case runAsUser == nil && !runAsNonRoot:
+			findings = append(findings, Finding{
+				Type:     "MayRunAsRoot",
+				Severity: severityInfo,
+				Message:  fmt.Sprintf("Container %s sets neither runAsUser nor runAsNonRoot, so runs as root if its image does", container.Name),
+			})
+		}
+
+		missing := []string{}
+		for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			if _, ok := container.Resources.Limits[name]; !ok && !limitsExempt[container.Name] {
+				missing = append(missing, string(name))
+			}
+		}
+		if len(missing) > 0 {
+			findings = append(findings, Finding{
+				Type:     "NoResourceLimits",
+				Severity: severityWarning,
+				Message:  fmt.Sprintf("Container %s has no %s limit", container.Name, strings.Join(missing, " or ")),
+			})
+		}
+
+		if tag, pinned := imageTag(container.Image); tag == "latest" && !pinned {
+			findings = append(findings, Finding{
+				Type:     "LatestTag",
+				Severity: severityWarning,
+				Message:  fmt.Sprintf("Container %s uses the image %s, which is not pinned to a version", container.Name, container.Image),
+			})
+		}
+	}
+	return findings
+}
+
+func SecurityPostureHandler(ctx context.Context, req *mcp.CallToolRequest, params SecurityPostureToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	labelSelector := ""
+	if params.LabelSelector != nil {
+		labelSelector = *params.LabelSelector
+	}
+
+	pods, err := listPodsInNamespaces(ctx, req, params.Namespaces, labelSelector)
+	if err != nil {
+		slog.Error("Failed to list pods from Kubernetes API", "tool", req.Params.Name, "namespaces", params.Namespaces, "error", err)
+		return nil, nil, err
+	}
+
+	// Pods of the same workload share a template, so their findings are reported once per workload.
+	result := SecurityPostureResult{Counts: map[string]int{}, Workloads: []WorkloadSecurityPosture{}}
+	workloads := map[string]*WorkloadSecurityPosture{}
+	for i := range pods {
+		pod := &pods[i]
+		if isPodTerminated(pod) {
+			continue
+		}
+		result.Pods++
+
+		key := pod.Namespace + "/" + podWorkload(pod)
+		workload, ok := workloads[key]
+		if !ok {
+			workload = &WorkloadSecurityPosture{Namespace: pod.Namespace, Workload: podWorkload(pod), Findings: []Finding{}}
+			workloads[key] = workload
+		}
+		workload.Pods++
+		for _, finding := range podSecurityFindings(pod) {
+			duplicate := slices.ContainsFunc(workload.Findings, func(existing Finding) bool {
+				return existing.Type == finding.Type && existing.Message == finding.Message
+			})
+			if !duplicate {
+				workload.Findings = append(workload.Findings, finding)
+			}
+		}
+	}
+
+	for _, workload := range workloads {
+		if len(workload.Findings) == 0 {
+			continue
+		}
+		sortFindings(workload.Findings)
+		for _, finding := range workload.Findings {
+			result.Counts[finding.Type]++
+		}
+		result.Workloads = append(result.Workloads, *workload)
+	}
+	// Workloads with the most severe findings are listed first.
+	slices.SortFunc(result.Workloads, func(a, b WorkloadSecurityPosture) int {
+		return cmp.Or(
+			cmp.Compare(severityOrder[a.Findings[0].Severity], severityOrder[b.Findings[0].Severity]),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Workload, b.Workload),
+		)
+	})
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal security posture", "namespaces", params.Namespaces, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
@@ -6,11 +6,30 @@ import (
 	"github.com/cturner8/kube-mcp/config"
 	"github.com/cturner8/kube-mcp/kubernetes"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
 	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/restmapper"
+	metrics "k8s.io/metrics/pkg/client/clientset/versioned"
 )
 
 var kubernetesApiClient *k8s.Clientset = kubernetes.CreateKubernetesApiClient(*config.ServerConfig.OutOfCluster, *config.ServerConfig.Kubeconfig)
 
+var kubernetesDynamicClient *dynamic.DynamicClient = kubernetes.CreateKubernetesDynamicClient(*config.ServerConfig.OutOfCluster, *config.ServerConfig.Kubeconfig)
+
+var kubernetesMetricsClient *metrics.Clientset = kubernetes.CreateKubernetesMetricsClient(*config.ServerConfig.OutOfCluster, *config.ServerConfig.Kubeconfig)
+
+// kubernetesCachedDiscoveryClient caches API discovery information in memory.
+var kubernetesCachedDiscoveryClient discovery.CachedDiscoveryInterface = memory.NewMemCacheClient(kubernetesApiClient.Discovery())
+
+// kubernetesRESTMapper resolves kinds to resources using cached API discovery.
+var kubernetesRESTMapper *restmapper.DeferredDiscoveryRESTMapper = restmapper.NewDeferredDiscoveryRESTMapper(kubernetesCachedDiscoveryClient)
+
+// kubernetesShortcutRESTMapper additionally resolves resource short names (e.g. "svc").
+var kubernetesShortcutRESTMapper meta.RESTMapper = restmapper.NewShortcutExpander(kubernetesRESTMapper, kubernetesCachedDiscoveryClient, nil)
+
 func IsToolAllowed(toolName string) bool {
 	allowedTools := config.ServerConfig.AllowedTools
 	disallowedTools := config.ServerConfig.DisallowedTools
@@ -32,3 +51,12 @@ func IsToolAllowed(toolName string) bool {
 
 	return false
 }
+
+// IsWriteToolAllowed checks a tool that modifies cluster resources is allowed.
+// Write tools are only allowed when write mode has been enabled.
+func IsWriteToolAllowed(toolName string) bool {
+	if !config.ServerConfig.WriteMode {
+		return false
+	}
+	return IsToolAllowed(toolName)
+}
@@ -0,0 +1,103 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"slices"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var TopNodesTool = &mcp.Tool{
+	Name:        "top_nodes",
+	Description: "List the CPU and memory usage of nodes in the Kubernetes cluster from the metrics API, compared with their allocatable capacity. Requires metrics-server",
+}
+
+type TopNodesToolParams struct {
+	LabelSelector *string `json:"labelSelector,omitempty" jsonschema:"A label selector to filter the nodes, e.g. node-role.kubernetes.io/control-plane"`
+	SortBy        *string `json:"sortBy,omitempty" jsonschema:"Sort the nodes by cpu or memory usage, highest first. Defaults to cpu"`
+}
+
+type NodeTopSummary struct {
+	Name   string        `json:"name"`
+	CPU    ResourceUsage `json:"cpu"`
+	Memory ResourceUsage `json:"memory"`
+
+	usage corev1.ResourceList
+}
+
+func summariseNodeResourceUsage(name corev1.ResourceName, usage corev1.ResourceList, allocatable corev1.ResourceList) ResourceUsage {
+	summary := ResourceUsage{
+		Usage:                formatQuantity(name, usage[name]),
+		PercentOfAllocatable: percentOf(usage[name], allocatable[name]),
+	}
+	if quantity, ok := allocatable[name]; ok {
+		summary.Allocatable = formatQuantity(name, quantity)
+	}
+	return summary
+}
+
+func TopNodesHandler(ctx context.Context, req *mcp.CallToolRequest, params TopNodesToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	listOptions := metav1.ListOptions{}
+	if params.LabelSelector != nil {
+		listOptions.LabelSelector = *params.LabelSelector
+	}
+	sortBy, err := normaliseMetricsSort(params.SortBy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nodeMetrics, err := kubernetesMetricsClient.MetricsV1beta1().NodeMetricses().List(ctx, listOptions)
+	if err != nil {
+		if isMetricsUnavailable(err) {
+			return metricsUnavailableResult(req.Params.Name, err), nil, nil
+		}
+		slog.Error("Failed to list node metrics from Kubernetes API", "tool", req.Params.Name, "error", err)
+		return nil, nil, err
+	}
+
+	nodes, err := kubernetesApiClient.CoreV1().Nodes().List(ctx, listOptions)
+	if err != nil {
+		slog.Error("Failed to list nodes from Kubernetes API", "tool", req.Params.Name, "error", err)
+		return nil, nil, err
+	}
+	allocatable := map[string]corev1.ResourceList{}
+	for _, node := range nodes.Items {
+		allocatable[node.Name] = node.Status.Allocatable
+	}
+
+	summaries := []NodeTopSummary{}
+	for _, metrics := range nodeMetrics.Items {
+		summaries = append(summaries, NodeTopSummary{
+			Name:   metrics.Name,
+			CPU:    summariseNodeResourceUsage(corev1.ResourceCPU, metrics.Usage, allocatable[metrics.Name]),
+			Memory: summariseNodeResourceUsage(corev1.ResourceMemory, metrics.Usage, allocatable[metrics.Name]),
+			usage:  metrics.Usage,
+		})
+	}
+
+	resourceName := corev1.ResourceName(sortBy)
+	slices.SortStableFunc(summaries, func(a, b NodeTopSummary) int {
+		usageA, usageB := a.usage[resourceName], b.usage[resourceName]
+		return cmp.Or(usageB.Cmp(usageA), cmp.Compare(a.Name, b.Name))
+	})
+
+	summariesJson, err := json.Marshal(summaries)
+	if err != nil {
+		slog.Error("Failed to marshal node metrics", "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(summariesJson)},
+		},
+	}, nil, nil
+}
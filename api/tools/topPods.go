@@ -0,0 +1,148 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"slices"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+var TopPodsTool = &mcp.Tool{
+	Name:        "top_pods",
+	Description: "List the CPU and memory usage of pods in the Kubernetes cluster from the metrics API, compared with the requests and limits in the pod spec. Requires metrics-server",
+}
+
+type TopPodsToolParams struct {
+	Namespace     *string `json:"namespace,omitempty" jsonschema:"The namespace of the pods, defaults to all namespaces"`
+	LabelSelector *string `json:"labelSelector,omitempty" jsonschema:"A label selector to filter the pods, e.g. app=nginx"`
+	SortBy        *string `json:"sortBy,omitempty" jsonschema:"Sort the pods by cpu or memory usage, highest first. Defaults to cpu"`
+	Limit         *int    `json:"limit,omitempty" jsonschema:"The maximum number of pods to return, defaults to all"`
+}
+
+type ContainerTopSummary struct {
+	Name   string        `json:"name"`
+	CPU    ResourceUsage `json:"cpu"`
+	Memory ResourceUsage `json:"memory"`
+}
+
+type PodTopSummary struct {
+	Namespace  string                `json:"namespace"`
+	Name       string                `json:"name"`
+	NodeName   string                `json:"nodeName,omitempty"`
+	CPU        ResourceUsage         `json:"cpu"`
+	Memory     ResourceUsage         `json:"memory"`
+	Containers []ContainerTopSummary `json:"containers"`
+
+	usage corev1.ResourceList
+}
+
+// summarisePodTop compares the usage of a pod's containers with their requests and limits.
+// The pod may be nil if it was deleted since the metrics were collected.
+func summarisePodTop(metrics *metricsv1beta1.PodMetrics, pod *corev1.Pod) PodTopSummary {
+	containerResources := map[string]corev1.ResourceRequirements{}
+	if pod != nil {
+		for _, container := range pod.Spec.Containers {
+			containerResources[container.Name] = container.Resources
+		}
+	}
+
+	summary := PodTopSummary{
+		Namespace:  metrics.Namespace,
+		Name:       metrics.Name,
+		Containers: []ContainerTopSummary{},
+		usage:      corev1.ResourceList{},
+	}
+	if pod != nil {
+		summary.NodeName = pod.Spec.NodeName
+	}
+
+	requests := corev1.ResourceList{}
+	limits := corev1.ResourceList{}
+	for _, container := range metrics.Containers {
+		resources := containerResources[container.Name]
+		addResourceList(summary.usage, container.Usage)
+		addResourceList(requests, resources.Requests)
+		addResourceList(limits, resources.Limits)
+
+		summary.Containers = append(summary.Containers, ContainerTopSummary{
+			Name:   container.Name,
+			CPU:    summariseResourceUsage(corev1.ResourceCPU, container.Usage, resources.Requests, resources.Limits),
+			Memory: summariseResourceUsage(corev1.ResourceMemory, container.Usage, resources.Requests, resources.Limits),
+		})
+	}
+	summary.CPU = summariseResourceUsage(corev1.ResourceCPU, summary.usage, requests, limits)
+	summary.Memory = summariseResourceUsage(corev1.ResourceMemory, summary.usage, requests, limits)
+	return summary
+}
+
+func TopPodsHandler(ctx context.Context, req *mcp.CallToolRequest, params TopPodsToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+	listOptions := metav1.ListOptions{}
+	if params.LabelSelector != nil {
+		listOptions.LabelSelector = *params.LabelSelector
+	}
+	sortBy, err := normaliseMetricsSort(params.SortBy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	podMetrics, err := kubernetesMetricsClient.MetricsV1beta1().PodMetricses(namespace).List(ctx, listOptions)
+	if err != nil {
+		if isMetricsUnavailable(err) {
+			return metricsUnavailableResult(req.Params.Name, err), nil, nil
+		}
+		slog.Error("Failed to list pod metrics from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	pods, err := kubernetesApiClient.CoreV1().Pods(namespace).List(ctx, listOptions)
+	if err != nil {
+		slog.Error("Failed to list pods from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+	podsByName := map[types.NamespacedName]*corev1.Pod{}
+	for i := range pods.Items {
+		podsByName[types.NamespacedName{Namespace: pods.Items[i].Namespace, Name: pods.Items[i].Name}] = &pods.Items[i]
+	}
+
+	summaries := []PodTopSummary{}
+	for i := range podMetrics.Items {
+		metrics := &podMetrics.Items[i]
+		pod := podsByName[types.NamespacedName{Namespace: metrics.Namespace, Name: metrics.Name}]
+		summaries = append(summaries, summarisePodTop(metrics, pod))
+	}
+
+	resourceName := corev1.ResourceName(sortBy)
+	slices.SortStableFunc(summaries, func(a, b PodTopSummary) int {
+		usageA, usageB := a.usage[resourceName], b.usage[resourceName]
+		return cmp.Or(usageB.Cmp(usageA), cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+	if params.Limit != nil && *params.Limit > 0 && len(summaries) > *params.Limit {
+		summaries = summaries[:*params.Limit]
+	}
+
+	summariesJson, err := json.Marshal(summaries)
+	if err != nil {
+		slog.Error("Failed to marshal pod metrics", "namespace", namespace, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(summariesJson)},
+		},
+	}, nil, nil
+}
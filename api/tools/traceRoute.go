@@ -0,0 +1,583 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	hopStatusOK      = "ok"
+	hopStatusWarning = "warning"
+	hopStatusError   = "error"
+)
+
+// Route match specificities, higher values take precedence.
+const (
+	routeMatchDefault = iota
+	routeMatchPrefix
+	routeMatchRegularExpression
+	routeMatchExact
+)
+
+var TraceRouteTool = &mcp.Tool{
+	Name:        "trace_route",
+	Description: "Trace how a request for a hostname and path is routed in the Kubernetes cluster. Finds the matching Ingress or Gateway API HTTPRoute rules and follows them through the Gateway, TLS secret, backend Service and its endpoints, reporting the status of each hop",
+}
+
+type TraceRouteToolParams struct {
+	Hostname  string  `json:"hostname" jsonschema:"The hostname of the request, e.g. app.example.com"`
+	Path      *string `json:"path,omitempty" jsonschema:"The path of the request, defaults to /"`
+	Namespace *string `json:"namespace,omitempty" jsonschema:"Only search for routes in this namespace, defaults to all namespaces"`
+}
+
+type RouteHop struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Status    string `json:"status"`
+	Message   string `json:"message"`
+}
+
+type RouteTrace struct {
+	Kind      string     `json:"kind"`
+	Name      string     `json:"name"`
+	Namespace string     `json:"namespace"`
+	Match     string     `json:"match"`
+	Healthy   bool       `json:"healthy"`
+	Hops      []RouteHop `json:"hops"`
+
+	hostSpecificity int
+	pathSpecificity int
+	pathLength      int
+}
+
+type TraceRouteResult struct {
+	Hostname string       `json:"hostname"`
+	Path     string       `json:"path"`
+	Routes   []RouteTrace `json:"routes"`
+	Errors   []string     `json:"errors,omitempty"`
+}
+
+// Gateway API types decoded from unstructured objects, limited to the fields used when tracing.
+type gatewayObjectReference struct {
+	Group       *string `json:"group,omitempty"`
+	Kind        *string `json:"kind,omitempty"`
+	Name        string  `json:"name"`
+	Namespace   *string `json:"namespace,omitempty"`
+	SectionName *string `json:"sectionName,omitempty"`
+	Port        *int32  `json:"port,omitempty"`
+}
+
+type gatewayRouteStatus struct {
+	Parents []struct {
+		ParentRef  gatewayObjectReference `json:"parentRef"`
+		Conditions []metav1.Condition     `json:"conditions,omitempty"`
+	} `json:"parents,omitempty"`
+}
+
+type httpRouteMatch struct {
+	Path *struct {
+		Type  *string `json:"type,omitempty"`
+		Value *string `json:"value,omitempty"`
+	} `json:"path,omitempty"`
+}
+
+type httpRoute struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              struct {
+		ParentRefs []gatewayObjectReference `json:"parentRefs,omitempty"`
+		Hostnames  []string                 `json:"hostnames,omitempty"`
+		Rules      []struct {
+			Matches     []httpRouteMatch         `json:"matches,omitempty"`
+			BackendRefs []gatewayObjectReference `json:"backendRefs,omitempty"`
+		} `json:"rules,omitempty"`
+	} `json:"spec"`
+	Status gatewayRouteStatus `json:"status"`
+}
+
+type gateway struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              struct {
+		GatewayClassName string `json:"gatewayClassName"`
+		Listeners        []struct {
+			Name     string  `json:"name"`
+			Hostname *string `json:"hostname,omitempty"`
+			Port     int32   `json:"port"`
+			Protocol string  `json:"protocol"`
+			TLS      *struct {
+				CertificateRefs []gatewayObjectReference `json:"certificateRefs,omitempty"`
+			} `json:"tls,omitempty"`
+		} `json:"listeners"`
+	} `json:"spec"`
+	Status struct {
+		Conditions []metav1.Condition `json:"conditions,omitempty"`
+	} `json:"status"`
+}
+
+// hostnameMatches matches a hostname against a route hostname, which may be
+// empty to match all hosts or have a wildcard first label. Ingress wildcards
+// match a single label, Gateway API wildcards match one or more labels.
+func hostnameMatches(pattern string, hostname string, singleLabelWildcard bool) (bool, int) {
+	switch {
+	case pattern == "":
+		return true, 0
+	case strings.EqualFold(pattern, hostname):
+		return true, 2
+	case strings.HasPrefix(pattern, "*."):
+		prefix, found := strings.CutSuffix(strings.ToLower(hostname), strings.ToLower(pattern[1:]))
+		if !found || prefix == "" || (singleLabelWildcard && strings.Contains(prefix, ".")) {
+			return false, 0
+		}
+		return true, 1
+	}
+	return false, 0
+}
+
+// pathPrefixMatches matches a path against a prefix element by element, so
+// /foo matches /foo and /foo/bar but not /foobar.
+func pathPrefixMatches(prefix string, path string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		return true
+	}
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// traceTLSSecret checks a TLS secret exists and holds a certificate which is
+// valid for the hostname and has not expired.
+func traceTLSSecret(ctx context.Context, namespace string, name string, hostname string) RouteHop {
+	hop := RouteHop{Kind: "Secret", Name: name, Namespace: namespace, Status: hopStatusOK}
+
+	secret, err := kubernetesApiClient.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsForbidden(err) {
+		// Reading secrets is not granted by default, so this is not a fault in the route.
+		hop.Status = hopStatusWarning
+		hop.Message = "The certificate could not be checked, reading the TLS secret is forbidden"
+		return hop
+	}
+	if err != nil {
+		hop.Status = hopStatusError
+		hop.Message = fmt.Sprintf("Failed to get TLS secret: %v", err)
+		return hop
+	}
+	block, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+	if block == nil {
+		hop.Status = hopStatusError
+		hop.Message = fmt.Sprintf("Secret has no PEM encoded certificate in %s", corev1.TLSCertKey)
+		return hop
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		hop.Status = hopStatusError
+		hop.Message = fmt.Sprintf("Failed to parse certificate: %v", err)
+		return hop
+	}
+
+	problems := []string{}
+	if time.Now().After(certificate.NotAfter) {
+		problems = append(problems, fmt.Sprintf("certificate expired at %s", certificate.NotAfter.UTC().Format(time.RFC3339)))
+	} else if time.Until(certificate.NotAfter) < 14*24*time.Hour {
+		hop.Status = hopStatusWarning
+		hop.Message = fmt.Sprintf("Certificate expires soon, at %s", certificate.NotAfter.UTC().Format(time.RFC3339))
+	}
+	if err := certificate.VerifyHostname(hostname); err != nil {
+		problems = append(problems, fmt.Sprintf("certificate is not valid for %s, it covers %s", hostname, strings.Join(certificate.DNSNames, ", ")))
+	}
+	if len(problems) > 0 {
+		hop.Status = hopStatusError
+		hop.Message = "The " + strings.Join(problems, " and the ")
+		return hop
+	}
+	if hop.Message == "" {
+		hop.Message = fmt.Sprintf("Certificate for %s is valid until %s", strings.Join(certificate.DNSNames, ", "), certificate.NotAfter.UTC().Format(time.RFC3339))
+	}
+	return hop
+}
+
+// traceServiceBackend checks a backend service exists and exposes the port,
+// and that it has ready endpoints.
+func traceServiceBackend(ctx context.Context, namespace string, name string, port *networkingv1.ServiceBackendPort) []RouteHop {
+	serviceHop := RouteHop{Kind: "Service", Name: name, Namespace: namespace, Status: hopStatusOK}
+
+	service, err := kubernetesApiClient.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		serviceHop.Status = hopStatusError
+		serviceHop.Message = fmt.Sprintf("Failed to get backend service: %v", err)
+		return []RouteHop{serviceHop}
+	}
+	serviceHop.Message = fmt.Sprintf("%s service", service.Spec.Type)
+	if port != nil {
+		exposed := slices.ContainsFunc(service.Spec.Ports, func(servicePort corev1.ServicePort) bool {
+			return (port.Name != "" && servicePort.Name == port.Name) || (port.Number != 0 && servicePort.Port == port.Number)
+		})
+		if !exposed {
+			serviceHop.Status = hopStatusError
+			serviceHop.Message = fmt.Sprintf("Service does not expose the backend port %s", formatBackendPort(port))
+		} else {
+			serviceHop.Message += fmt.Sprintf(" exposing port %s", formatBackendPort(port))
+		}
+	}
+	if service.Spec.Type == corev1.ServiceTypeExternalName {
+		serviceHop.Message += fmt.Sprintf(", resolving to %s", service.Spec.ExternalName)
+		return []RouteHop{serviceHop}
+	}
+
+	endpointsHop := RouteHop{Kind: "EndpointSlice", Name: name, Namespace: namespace, Status: hopStatusOK}
+	endpointSlices, err := kubernetesApiClient.DiscoveryV1().EndpointSlices(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", discoveryv1.LabelServiceName, name),
+	})
+	if err != nil {
+		endpointsHop.Status = hopStatusError
+		endpointsHop.Message = fmt.Sprintf("Failed to list endpoint slices: %v", err)
+		return []RouteHop{serviceHop, endpointsHop}
+	}
+	ready, total := 0, 0
+	for i := range endpointSlices.Items {
+		for _, endpoint := range summariseEndpointSlice(&endpointSlices.Items[i]).Endpoints {
+			total++
+			if endpoint.Ready {
+				ready++
+			}
+		}
+	}
+	endpointsHop.Message = fmt.Sprintf("%d/%d endpoints ready", ready, total)
+	if ready == 0 {
+		endpointsHop.Status = hopStatusError
+		endpointsHop.Message += ", use analyze_service to find out why"
+	} else if ready < total {
+		endpointsHop.Status = hopStatusWarning
+	}
+	return []RouteHop{serviceHop, endpointsHop}
+}
+
+func formatBackendPort(port *networkingv1.ServiceBackendPort) string {
+	if port.Name != "" {
+		return port.Name
+	}
+	return fmt.Sprint(port.Number)
+}
+
+func traceIngresses(ctx context.Context, namespace string, hostname string, path string) ([]RouteTrace, error) {
+	ingresses, err := kubernetesApiClient.NetworkingV1().Ingresses(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	traces := []RouteTrace{}
+	for i := range ingresses.Items {
+		ingress := &ingresses.Items[i]
+
+		// Find the most specific matching rule path, falling back to the default backend.
+		var backend *networkingv1.IngressBackend
+		trace := RouteTrace{Kind: "Ingress", Name: ingress.Name, Namespace: ingress.Namespace, pathSpecificity: -1}
+		for _, rule := range ingress.Spec.Rules {
+			hostMatches, hostSpecificity := hostnameMatches(rule.Host, hostname, true)
+			if !hostMatches || rule.HTTP == nil {
+				continue
+			}
+			for _, rulePath := range rule.HTTP.Paths {
+				specificity := routeMatchPrefix
+				matches := pathPrefixMatches(rulePath.Path, path)
+				if rulePath.PathType != nil && *rulePath.PathType == networkingv1.PathTypeExact {
+					specificity = routeMatchExact
+					matches = rulePath.Path == path
+				}
+				better := cmp.Or(cmp.Compare(hostSpecificity, trace.hostSpecificity), cmp.Compare(specificity, trace.pathSpecificity), cmp.Compare(len(rulePath.Path), trace.pathLength)) > 0
+				if matches && (backend == nil || better) {
+					backend = &rulePath.Backend
+					trace.hostSpecificity, trace.pathSpecificity, trace.pathLength = hostSpecificity, specificity, len(rulePath.Path)
+					trace.Match = fmt.Sprintf("host %q path %q", rule.Host, rulePath.Path)
+				}
+			}
+		}
+		if backend == nil && ingress.Spec.DefaultBackend != nil {
+			backend = ingress.Spec.DefaultBackend
+			trace.pathSpecificity = routeMatchDefault
+			trace.Match = "default backend"
+		}
+		if backend == nil {
+			continue
+		}
+
+		ingressHop := RouteHop{Kind: "Ingress", Name: ingress.Name, Namespace: ingress.Namespace, Status: hopStatusOK}
+		className := "the default class"
+		if ingress.Spec.IngressClassName != nil {
+			className = *ingress.Spec.IngressClassName
+		}
+		ingressHop.Message = fmt.Sprintf("Matched %s, handled by ingress class %s", trace.Match, className)
+		if len(ingress.Status.LoadBalancer.Ingress) == 0 {
+			ingressHop.Status = hopStatusWarning
+			ingressHop.Message += ", no load balancer address has been assigned"
+		}
+		trace.Hops = append(trace.Hops, ingressHop)
+
+		for _, tls := range ingress.Spec.TLS {
+			covered := len(tls.Hosts) == 0 || slices.ContainsFunc(tls.Hosts, func(host string) bool {
+				matches, _ := hostnameMatches(host, hostname, true)
+				return matches
+			})
+			if covered && tls.SecretName != "" {
+				trace.Hops = append(trace.Hops, traceTLSSecret(ctx, ingress.Namespace, tls.SecretName, hostname))
+				break
+			}
+		}
+
+		switch {
+		case backend.Service != nil:
+			trace.Hops = append(trace.Hops, traceServiceBackend(ctx, ingress.Namespace, backend.Service.Name, &backend.Service.Port)...)
+		case backend.Resource != nil:
+			trace.Hops = append(trace.Hops, RouteHop{Kind: backend.Resource.Kind, Name: backend.Resource.Name, Namespace: ingress.Namespace, Status: hopStatusOK, Message: "Resource backend, not traced further"})
+		}
+		traces = append(traces, trace)
+	}
+	return traces, nil
+}
+
+// httpRoutePathMatches matches a path against an HTTPRoute path match, returning its specificity.
+func httpRoutePathMatches(matchType string, value string, path string) (bool, int) {
+	switch matchType {
+	case "Exact":
+		return value == path, routeMatchExact
+	case "RegularExpression":
+		expression, err := regexp.Compile("^(?:" + value + ")$")
+		return err == nil && expression.MatchString(path), routeMatchRegularExpression
+	default:
+		return pathPrefixMatches(value, path), routeMatchPrefix
+	}
+}
+
+// traceGateway checks a route's parent gateway is programmed and has a
+// listener for the hostname, including its TLS certificate.
+func traceGateway(ctx context.Context, reference gatewayObjectReference, routeNamespace string, hostname string) []RouteHop {
+	namespace := routeNamespace
+	if reference.Namespace != nil {
+		namespace = *reference.Namespace
+	}
+	hop := RouteHop{Kind: "Gateway", Name: reference.Name, Namespace: namespace, Status: hopStatusOK}
+
+	object, err := getGatewayAPIResource(ctx, gatewayAPIGateways, namespace, reference.Name)
+	if err != nil {
+		hop.Status = hopStatusError
+		hop.Message = fmt.Sprintf("Failed to get gateway: %v", err)
+		return []RouteHop{hop}
+	}
+	var parent gateway
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object, &parent); err != nil {
+		hop.Status = hopStatusError
+		hop.Message = fmt.Sprintf("Failed to decode gateway: %v", err)
+		return []RouteHop{hop}
+	}
+
+	hops := []RouteHop{}
+	listeners := []string{}
+	for _, listener := range parent.Spec.Listeners {
+		if reference.SectionName != nil && *reference.SectionName != listener.Name {
+			continue
+		}
+		listenerHostname := ""
+		if listener.Hostname != nil {
+			listenerHostname = *listener.Hostname
+		}
+		if matches, _ := hostnameMatches(listenerHostname, hostname, false); !matches {
+			continue
+		}
+		listeners = append(listeners, fmt.Sprintf("%s (%s/%d)", listener.Name, listener.Protocol, listener.Port))
+		if listener.TLS != nil {
+			for _, certificate := range listener.TLS.CertificateRefs {
+				certificateNamespace := parent.Namespace
+				if certificate.Namespace != nil {
+					certificateNamespace = *certificate.Namespace
+				}
+				hops = append(hops, traceTLSSecret(ctx, certificateNamespace, certificate.Name, hostname))
+			}
+		}
+	}
+
+	hop.Message = fmt.Sprintf("Gateway class %s", parent.Spec.GatewayClassName)
+	if len(listeners) == 0 {
+		hop.Status = hopStatusError
+		hop.Message += fmt.Sprintf(", no listener accepts the hostname %s", hostname)
+	} else {
+		hop.Message += fmt.Sprintf(", listeners %s", strings.Join(listeners, ", "))
+	}
+	if programmed := meta.FindStatusCondition(parent.Status.Conditions, "Programmed"); programmed == nil || programmed.Status != metav1.ConditionTrue {
+		hop.Status = hopStatusError
+		hop.Message += ", gateway is not programmed"
+		if programmed != nil {
+			hop.Message += fmt.Sprintf(": %s %s", programmed.Reason, programmed.Message)
+		}
+	}
+	return append([]RouteHop{hop}, hops...)
+}
+
+func traceHTTPRoutes(ctx context.Context, namespace string, hostname string, path string) ([]RouteTrace, error) {
+	client, err := gatewayAPIResource(gatewayAPIHTTPRoutes, namespace)
+	if err != nil {
+		return nil, err
+	}
+	list, err := client.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	traces := []RouteTrace{}
+	for _, item := range list.Items {
+		var route httpRoute
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &route); err != nil {
+			return nil, fmt.Errorf("HTTPRoute %s/%s: %w", item.GetNamespace(), item.GetName(), err)
+		}
+
+		trace := RouteTrace{Kind: "HTTPRoute", Name: route.Name, Namespace: route.Namespace, pathSpecificity: -1}
+		hostnames := route.Spec.Hostnames
+		if len(hostnames) == 0 {
+			hostnames = []string{""}
+		}
+		hostMatches := false
+		for _, routeHostname := range hostnames {
+			if matches, specificity := hostnameMatches(routeHostname, hostname, false); matches {
+				hostMatches = true
+				trace.hostSpecificity = max(trace.hostSpecificity, specificity)
+			}
+		}
+		if !hostMatches {
+			continue
+		}
+
+		// Find the most specific matching rule, rules without matches match all paths.
+		backends := []gatewayObjectReference{}
+		for _, rule := range route.Spec.Rules {
+			matches := rule.Matches
+			if len(matches) == 0 {
+				matches = []httpRouteMatch{{}}
+			}
+			for _, match := range matches {
+				matchType, value := "PathPrefix", "/"
+				if match.Path != nil && match.Path.Type != nil {
+					matchType = *match.Path.Type
+				}
+				if match.Path != nil && match.Path.Value != nil {
+					value = *match.Path.Value
+				}
+				matched, specificity := httpRoutePathMatches(matchType, value, path)
+				better := cmp.Or(cmp.Compare(specificity, trace.pathSpecificity), cmp.Compare(len(value), trace.pathLength)) > 0
+				if matched && better {
+					backends = rule.BackendRefs
+					trace.pathSpecificity, trace.pathLength = specificity, len(value)
+					trace.Match = fmt.Sprintf("%s %q", matchType, value)
+				}
+			}
+		}
+		if trace.pathSpecificity < 0 {
+			continue
+		}
+
+		for _, parentRef := range route.Spec.ParentRefs {
+			if parentRef.Kind != nil && *parentRef.Kind != "Gateway" {
+				continue
+			}
+			trace.Hops = append(trace.Hops, traceGateway(ctx, parentRef, route.Namespace, hostname)...)
+		}
+
+		routeHop := RouteHop{Kind: "HTTPRoute", Name: route.Name, Namespace: route.Namespace, Status: hopStatusOK, Message: fmt.Sprintf("Matched %s", trace.Match)}
+		for _, parent := range route.Status.Parents {
+			for _, conditionType := range []string{"Accepted", "ResolvedRefs"} {
+				condition := meta.FindStatusCondition(parent.Conditions, conditionType)
+				if condition != nil && condition.Status != metav1.ConditionTrue {
+					routeHop.Status = hopStatusError
+					routeHop.Message += fmt.Sprintf(", not %s by %s: %s %s", conditionType, parent.ParentRef.Name, condition.Reason, condition.Message)
+				}
+			}
+		}
+		if len(backends) == 0 {
+			routeHop.Status = hopStatusError
+			routeHop.Message += ", the rule has no backends"
+		}
+		trace.Hops = append(trace.Hops, routeHop)
+
+		for _, backend := range backends {
+			if backend.Kind != nil && *backend.Kind != "Service" {
+				trace.Hops = append(trace.Hops, RouteHop{Kind: *backend.Kind, Name: backend.Name, Namespace: route.Namespace, Status: hopStatusOK, Message: "Non-service backend, not traced further"})
+				continue
+			}
+			backendNamespace := route.Namespace
+			if backend.Namespace != nil {
+				backendNamespace = *backend.Namespace
+			}
+			var port *networkingv1.ServiceBackendPort
+			if backend.Port != nil {
+				port = &networkingv1.ServiceBackendPort{Number: *backend.Port}
+			}
+			trace.Hops = append(trace.Hops, traceServiceBackend(ctx, backendNamespace, backend.Name, port)...)
+		}
+		traces = append(traces, trace)
+	}
+	return traces, nil
+}
+
+func TraceRouteHandler(ctx context.Context, req *mcp.CallToolRequest, params TraceRouteToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+	path := "/"
+	if params.Path != nil && *params.Path != "" {
+		path = *params.Path
+	}
+	hostname := strings.ToLower(strings.TrimSuffix(params.Hostname, "."))
+
+	result := TraceRouteResult{Hostname: hostname, Path: path, Routes: []RouteTrace{}, Errors: []string{}}
+
+	// Ingresses and HTTPRoutes are both searched, either may be missing from the cluster.
+	ingressTraces, err := traceIngresses(ctx, namespace, hostname, path)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("ingresses: %v", err))
+	}
+	result.Routes = append(result.Routes, ingressTraces...)
+
+	httpRouteTraces, err := traceHTTPRoutes(ctx, namespace, hostname, path)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("httproutes: %v", err))
+	}
+	result.Routes = append(result.Routes, httpRouteTraces...)
+
+	for i := range result.Routes {
+		result.Routes[i].Healthy = !slices.ContainsFunc(result.Routes[i].Hops, func(hop RouteHop) bool { return hop.Status == hopStatusError })
+	}
+	// The most specific match is listed first.
+	slices.SortStableFunc(result.Routes, func(a, b RouteTrace) int {
+		return cmp.Or(cmp.Compare(b.hostSpecificity, a.hostSpecificity), cmp.Compare(b.pathSpecificity, a.pathSpecificity), cmp.Compare(b.pathLength, a.pathLength))
+	})
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal route trace", "hostname", hostname, "path", path, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,96 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// cronJobInstantiateAnnotation marks jobs created manually from a cronjob, as set by `kubectl create job --from`.
+const cronJobInstantiateAnnotation = "cronjob.kubernetes.io/instantiate"
+
+var TriggerCronJobTool = &mcp.Tool{
+	Name:        "trigger_cron_job",
+	Description: "Create a job from a cronjob's job template in the Kubernetes cluster, running it immediately",
+}
+
+type TriggerCronJobToolParams struct {
+	Name      string  `json:"name" jsonschema:"The name of the cronjob"`
+	Namespace string  `json:"namespace" jsonschema:"The namespace of the cronjob"`
+	JobName   *string `json:"jobName,omitempty" jsonschema:"The name of the job to create, defaults to the cronjob name with a manual suffix"`
+}
+
+// manualJobName names a job created manually from a cronjob, truncating the
+// cronjob name so the job name fits in the job-name label the Job controller adds.
+func manualJobName(cronJobName string, now time.Time) string {
+	suffix := fmt.Sprintf("-manual-%d", now.Unix())
+	if len(cronJobName)+len(suffix) > validation.DNS1123LabelMaxLength {
+		cronJobName = strings.TrimRight(cronJobName[:validation.DNS1123LabelMaxLength-len(suffix)], "-.")
+	}
+	return cronJobName + suffix
+}
+
+func TriggerCronJobHandler(ctx context.Context, req *mcp.CallToolRequest, params TriggerCronJobToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	cronJob, err := kubernetesApiClient.BatchV1().CronJobs(params.Namespace).Get(ctx, params.Name, metav1.GetOptions{})
+	if err != nil {
+		slog.Error("Failed to get cronjob from Kubernetes API", "tool", req.Params.Name, "cronjob", params.Name, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	jobName := manualJobName(params.Name, time.Now())
+	if params.JobName != nil && *params.JobName != "" {
+		jobName = *params.JobName
+	}
+
+	annotations := map[string]string{cronJobInstantiateAnnotation: "manual"}
+	maps.Copy(annotations, cronJob.Spec.JobTemplate.Annotations)
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        jobName,
+			Namespace:   params.Namespace,
+			Labels:      cronJob.Spec.JobTemplate.Labels,
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cronJob, batchv1.SchemeGroupVersion.WithKind("CronJob")),
+			},
+		},
+		Spec: *cronJob.Spec.JobTemplate.Spec.DeepCopy(),
+	}
+
+	action := fmt.Sprintf("create job %s from cronjob %s in namespace %s", jobName, params.Name, params.Namespace)
+	if err := confirmAction(ctx, req, action); err != nil {
+		return nil, nil, err
+	}
+
+	job, err = kubernetesApiClient.BatchV1().Jobs(params.Namespace).Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		slog.Error("Failed to create job from cronjob", "tool", req.Params.Name, "cronjob", params.Name, "job", jobName, "namespace", params.Namespace, "error", err)
+		return nil, nil, err
+	}
+
+	slog.Info("Job created from cronjob", "cronjob", params.Name, "job", job.Name, "namespace", params.Namespace)
+
+	jobJson, err := json.Marshal(summariseJob(job))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jobJson)},
+		},
+	}, nil, nil
+}
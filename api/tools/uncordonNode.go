@@ -0,0 +1,50 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var UncordonNodeTool = &mcp.Tool{
+	Name:        "uncordon_node",
+	Description: "Mark a node in the Kubernetes cluster as schedulable",
+}
+
+type UncordonNodeToolParams struct {
+	Name string `json:"name" jsonschema:"The name of the node"`
+}
+
+func UncordonNodeHandler(ctx context.Context, req *mcp.CallToolRequest, params UncordonNodeToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	if err := confirmAction(ctx, req, fmt.Sprintf("uncordon node %s", params.Name)); err != nil {
+		return nil, nil, err
+	}
+
+	previous, err := setNodeUnschedulable(ctx, params.Name, false)
+	if err != nil {
+		slog.Error("Failed to uncordon node", "tool", req.Params.Name, "node", params.Name, "error", err)
+		return nil, nil, err
+	}
+
+	slog.Info("Node uncordoned", "node", params.Name)
+
+	resultJson, err := json.Marshal(CordonNodeResult{
+		Name:                  params.Name,
+		Unschedulable:         false,
+		PreviousUnschedulable: previous,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,168 @@
+package tools
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var WhoCanTool = &mcp.Tool{
+	Name:        "who_can",
+	Description: "Find the users, groups and service accounts allowed to perform a verb on a resource in the Kubernetes cluster, by walking the RBAC role bindings and cluster role bindings",
+}
+
+type WhoCanToolParams struct {
+	Verb      string  `json:"verb" jsonschema:"The verb to check, e.g. get, list, create or delete"`
+	Resource  string  `json:"resource" jsonschema:"The resource to check, e.g. pods, deployments or pods/log for a subresource"`
+	Group     *string `json:"group,omitempty" jsonschema:"The API group of the resource, resolved from the resource when not given"`
+	Namespace *string `json:"namespace,omitempty" jsonschema:"The namespace to check, role bindings in the namespace are included. Only cluster role bindings are checked when not given"`
+	Name      *string `json:"name,omitempty" jsonschema:"The name of a specific object to check, includes rules restricted to that object"`
+}
+
+type AccessGrant struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Binding   string `json:"binding"`
+	Role      string `json:"role"`
+}
+
+type WhoCanResult struct {
+	Verb      string         `json:"verb"`
+	Resource  AccessResource `json:"resource"`
+	Namespace string         `json:"namespace,omitempty"`
+	Name      string         `json:"name,omitempty"`
+	Subjects  []AccessGrant  `json:"subjects"`
+	Notes     []string       `json:"notes"`
+}
+
+// roleRulesAllow checks whether any of the rules of a role grant the access.
+func roleRulesAllow(rules []rbacv1.PolicyRule, verb string, resource AccessResource, name string) bool {
+	return slices.ContainsFunc(rules, func(rule rbacv1.PolicyRule) bool {
+		return ruleAllows(rule, verb, resource, name)
+	})
+}
+
+// bindingGrants returns the subjects of a binding as access grants.
+func bindingGrants(subjects []rbacv1.Subject, binding string, role string) []AccessGrant {
+	grants := []AccessGrant{}
+	for _, subject := range subjects {
+		grants = append(grants, AccessGrant{
+			Kind:      subject.Kind,
+			Name:      subject.Name,
+			Namespace: subject.Namespace,
+			Binding:   binding,
+			Role:      role,
+		})
+	}
+	return grants
+}
+
+func WhoCanHandler(ctx context.Context, req *mcp.CallToolRequest, params WhoCanToolParams) (*mcp.CallToolResult, any, error) {
+	slog.Debug("Tool invoked", "tool", req.Params.Name)
+
+	namespace := ""
+	if params.Namespace != nil {
+		namespace = *params.Namespace
+	}
+	name := ""
+	if params.Name != nil {
+		name = *params.Name
+	}
+	resource := resolveAccessResource(params.Resource, params.Group)
+
+	clusterRoles, err := kubernetesApiClient.RbacV1().ClusterRoles().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		slog.Error("Failed to list cluster roles from Kubernetes API", "tool", req.Params.Name, "error", err)
+		return nil, nil, err
+	}
+	clusterRoleAllows := map[string]bool{}
+	for _, role := range clusterRoles.Items {
+		clusterRoleAllows[role.Name] = roleRulesAllow(role.Rules, params.Verb, resource, name)
+	}
+
+	result := WhoCanResult{
+		Verb:      params.Verb,
+		Resource:  resource,
+		Namespace: namespace,
+		Name:      name,
+		Subjects:  []AccessGrant{},
+		Notes: []string{
+			"Members of the system:masters group bypass RBAC and are always allowed",
+			"Access granted by other authorizers, e.g. webhooks or the node authorizer, is not included",
+		},
+	}
+
+	clusterRoleBindings, err := kubernetesApiClient.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		slog.Error("Failed to list cluster role bindings from Kubernetes API", "tool", req.Params.Name, "error", err)
+		return nil, nil, err
+	}
+	for _, binding := range clusterRoleBindings.Items {
+		if clusterRoleAllows[binding.RoleRef.Name] {
+			result.Subjects = append(result.Subjects, bindingGrants(binding.Subjects, "ClusterRoleBinding/"+binding.Name, "ClusterRole/"+binding.RoleRef.Name)...)
+		}
+	}
+
+	if namespace != "" {
+		roles, err := kubernetesApiClient.RbacV1().Roles(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			slog.Error("Failed to list roles from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+			return nil, nil, err
+		}
+		roleAllows := map[string]bool{}
+		for _, role := range roles.Items {
+			roleAllows[role.Name] = roleRulesAllow(role.Rules, params.Verb, resource, name)
+		}
+
+		roleBindings, err := kubernetesApiClient.RbacV1().RoleBindings(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			slog.Error("Failed to list role bindings from Kubernetes API", "tool", req.Params.Name, "namespace", namespace, "error", err)
+			return nil, nil, err
+		}
+		for _, binding := range roleBindings.Items {
+			allowed := roleAllows[binding.RoleRef.Name]
+			if binding.RoleRef.Kind == "ClusterRole" {
+				allowed = clusterRoleAllows[binding.RoleRef.Name]
+			}
+			if !allowed {
+				continue
+			}
+			role := fmt.Sprintf("%s/%s", binding.RoleRef.Kind, binding.RoleRef.Name)
+			grants := bindingGrants(binding.Subjects, "RoleBinding/"+binding.Name, role)
+			// Service accounts in role bindings default to the namespace of the binding.
+			for i := range grants {
+				if grants[i].Kind == rbacv1.ServiceAccountKind && grants[i].Namespace == "" {
+					grants[i].Namespace = namespace
+				}
+			}
+			result.Subjects = append(result.Subjects, grants...)
+		}
+	} else {
+		result.Notes = append(result.Notes, "Role bindings are only checked when a namespace is given")
+	}
+
+	slices.SortFunc(result.Subjects, func(a, b AccessGrant) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name), cmp.Compare(a.Binding, b.Binding))
+	})
+
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		slog.Error("Failed to marshal access subjects", "verb", params.Verb, "resource", params.Resource, "error", err)
+		return nil, nil, err
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(resultJson)},
+		},
+	}, nil, nil
+}
@@ -0,0 +1,27 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	workloadKindDeployment  = "Deployment"
+	workloadKindStatefulSet = "StatefulSet"
+	workloadKindDaemonSet   = "DaemonSet"
+)
+
+// normaliseWorkloadKind maps user supplied workload kinds (e.g. "deployment",
+// "deployments", "deploy") onto their canonical apps/v1 kind.
+func normaliseWorkloadKind(kind string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(kind)) {
+	case "deployment", "deployments", "deploy":
+		return workloadKindDeployment, nil
+	case "statefulset", "statefulsets", "sts":
+		return workloadKindStatefulSet, nil
+	case "daemonset", "daemonsets", "ds":
+		return workloadKindDaemonSet, nil
+	default:
+		return "", fmt.Errorf("unsupported workload kind %q, expected one of Deployment, StatefulSet or DaemonSet", kind)
+	}
+}